@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
@@ -12,12 +13,18 @@ import (
 
 	simpleproducer "github.com/VladimirButakov/otus-project/internal/amqp/producer"
 	"github.com/VladimirButakov/otus-project/internal/app"
+	"github.com/VladimirButakov/otus-project/internal/auth"
 	"github.com/VladimirButakov/otus-project/internal/bandit"
 	"github.com/VladimirButakov/otus-project/internal/config"
 	"github.com/VladimirButakov/otus-project/internal/logger"
+	"github.com/VladimirButakov/otus-project/internal/retention"
 	gw "github.com/VladimirButakov/otus-project/internal/server/grpc"
+	"github.com/VladimirButakov/otus-project/internal/storage/eventwriter"
 	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	sqlitestorage "github.com/VladimirButakov/otus-project/internal/storage/sqlite"
+	"github.com/VladimirButakov/otus-project/internal/tracing"
 	"github.com/VladimirButakov/otus-project/internal/version"
+	"github.com/VladimirButakov/otus-project/migrations"
 	_ "github.com/lib/pq"
 	"github.com/streadway/amqp"
 )
@@ -48,6 +55,13 @@ func main() {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	shutdownTracing, err := tracing.New(ctx, configuration.Tracing)
+	if err != nil {
+		logg.Error(err.Error())
+
+		log.Fatal(err)
+	}
+
 	storage, err := initStorage(ctx, configuration)
 	if err != nil {
 		logg.Error(err.Error())
@@ -55,6 +69,22 @@ func main() {
 		log.Fatal(err)
 	}
 
+	retentionJob := retention.New(logg, storage, configuration.Retention)
+
+	if flag.Arg(0) == "purge" {
+		err := retentionJob.Purge(ctx)
+
+		cancel()
+
+		if err != nil {
+			logg.Error(err.Error())
+
+			log.Fatal(err)
+		}
+
+		return
+	}
+
 	conn, err := amqp.Dial(configuration.AMPQ.URI)
 	if err != nil {
 		logg.Error(fmt.Errorf("cannot connect to amqp, %w", err).Error())
@@ -68,16 +98,56 @@ func main() {
 
 	bandit := bandit.New()
 
-	brApp := app.New(logg, storage, bandit, producer)
+	var (
+		appStorage  app.TxStorage = storage
+		eventWriter *eventwriter.Writer
+	)
+
+	if configuration.Events.Async {
+		eventWriter, err = eventwriter.New(logg, storage,
+			configuration.Events.QueueSize, configuration.Events.BatchSize, configuration.Events.FlushInterval)
+		if err != nil {
+			logg.Error(err.Error())
+
+			log.Fatal(err)
+		}
+
+		appStorage = eventWriter
+
+		go eventWriter.Run()
+	}
+
+	brApp := app.New(logg, appStorage, bandit, producer)
+
+	var authenticator *auth.Authenticator
 
-	server, err := gw.NewServer(brApp, configuration.HTTP.Host, configuration.HTTP.Port, configuration.HTTP.GrpcPort)
+	if configuration.Auth.Enabled {
+		authenticator, err = auth.New(configuration.Auth)
+		if err != nil {
+			logg.Error(err.Error())
+
+			log.Fatal(err)
+		}
+	}
+
+	checks := map[string]gw.ReadinessCheck{"storage": storage.Connect, "amqp": producer.Check}
+
+	server, err := gw.NewServer(brApp, authenticator, configuration.RateLimit, checks,
+		configuration.Events.ClicksWithoutImpression, configuration.HTTP.Host, configuration.HTTP.Port, configuration.HTTP.GrpcPort)
 	if err != nil {
 		logg.Error(err.Error())
 	}
 
 	defer cancel()
 
+	go retentionJob.Run(ctx)
+	go storage.RunReplicaHealthChecks(ctx, configuration.DB.ReplicaCheckInterval)
+
+	stopped := make(chan struct{})
+
 	go func() {
+		defer close(stopped)
+
 		signals := make(chan os.Signal, 1)
 		signal.Notify(signals, syscall.SIGINT, syscall.SIGHUP)
 
@@ -96,6 +166,18 @@ func main() {
 		if err := server.Stop(ctx); err != nil {
 			logg.Error("failed to stop grpc server: " + err.Error())
 		}
+
+		if eventWriter != nil {
+			if err := eventWriter.Stop(ctx); err != nil {
+				logg.Error("failed to flush events: " + err.Error())
+			}
+
+			logg.Info("event writer stopped", "dropped", eventWriter.Dropped())
+		}
+
+		if err := shutdownTracing(ctx); err != nil {
+			logg.Error("failed to flush spans: " + err.Error())
+		}
 	}()
 
 	logg.Info("banners rotation service is running...")
@@ -105,10 +187,27 @@ func main() {
 		cancel()
 		os.Exit(1) //nolint:gocritic
 	}
+
+	<-stopped
 }
 
+var errUnknownDriver = errors.New("unknown db driver")
+
 func initStorage(ctx context.Context, configuration config.Config) (*sqlstorage.Storage, error) {
-	storage, err := sqlstorage.New(ctx, configuration.DB.ConnectionString)
+	var (
+		storage *sqlstorage.Storage
+		err     error
+	)
+
+	switch configuration.DB.Driver {
+	case "postgres":
+		storage, err = sqlstorage.New(ctx, configuration.DB.ConnectionString, configuration.DB.QueryTimeout)
+	case "sqlite":
+		storage, err = sqlitestorage.New(ctx, configuration.DB.ConnectionString, configuration.DB.QueryTimeout)
+	default:
+		err = fmt.Errorf("%w: %s", errUnknownDriver, configuration.DB.Driver)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("can't create new storage instance, %w", err)
 	}
@@ -118,5 +217,18 @@ func initStorage(ctx context.Context, configuration config.Config) (*sqlstorage.
 		return nil, fmt.Errorf("can't connect to storage, %w", err)
 	}
 
+	// SQLite storage applies its migrations when the file is opened.
+	if configuration.DB.Driver == "postgres" {
+		err = storage.Migrate(ctx, migrations.Postgres)
+		if err != nil {
+			return nil, fmt.Errorf("can't migrate storage, %w", err)
+		}
+	}
+
+	err = storage.ConnectReplicas(ctx, configuration.DB.Replicas)
+	if err != nil {
+		return nil, fmt.Errorf("can't connect to storage replicas, %w", err)
+	}
+
 	return storage, nil
 }
@@ -1,14 +1,27 @@
 package simpleproducer
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
+	"github.com/VladimirButakov/otus-project/internal/metrics"
 	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
-var errPublish = errors.New("cannot publish message because channel isn't declared")
+var tracer = otel.Tracer("github.com/VladimirButakov/otus-project/internal/amqp/producer")
+
+var (
+	errPublish       = errors.New("cannot publish message because channel isn't declared")
+	ErrChannelClosed = errors.New("channel is closed")
+)
 
 type AMQPMessage struct {
 	Type         string `json:"type"`
@@ -26,6 +39,7 @@ type Producer struct {
 	name    string
 	conn    RMQConnection
 	channel *amqp.Channel
+	closed  atomic.Bool
 }
 
 func New(name string, conn RMQConnection) *Producer {
@@ -40,6 +54,13 @@ func (p *Producer) Connect() error {
 
 	p.channel = ch
 
+	closed := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+	go func() {
+		<-closed
+		p.closed.Store(true)
+	}()
+
 	_, err = ch.QueueDeclare(p.name, false,
 		false,
 		false,
@@ -52,19 +73,61 @@ func (p *Producer) Connect() error {
 	return nil
 }
 
-func (p *Producer) Publish(message AMQPMessage) error {
+// Check returns an error while the channel is not declared or is closed.
+func (p *Producer) Check(ctx context.Context) error {
+	if p.channel == nil {
+		return errPublish
+	}
+
+	if p.closed.Load() {
+		return ErrChannelClosed
+	}
+
+	return nil
+}
+
+// Publish sends the message to the queue with the trace context in its headers, and
+// counts the result in the publishes metric.
+func (p *Producer) Publish(ctx context.Context, message AMQPMessage) error {
+	ctx, span := tracer.Start(ctx, p.name+" send", trace.WithSpanKind(trace.SpanKindProducer), trace.WithAttributes(
+		semconv.MessagingSystemKey.String("rabbitmq"),
+		semconv.MessagingDestinationKey.String(p.name),
+		attribute.String("messaging.message_type", message.Type),
+	))
+	defer span.End()
+
+	err := p.publish(ctx, message)
+	metrics.AMQPPublishes.WithLabelValues(metrics.Result(err)).Inc()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *Producer) publish(ctx context.Context, message AMQPMessage) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("cannot publish message, %w", err)
+	}
+
 	if p.channel != nil {
 		bytes, err := json.Marshal(message)
 		if err != nil {
 			return fmt.Errorf("cannot marshall message, %w", err)
 		}
 
+		headers := amqp.Table{}
+		otel.GetTextMapPropagator().Inject(ctx, HeadersCarrier(headers))
+
 		err = p.channel.Publish(
 			"",     // exchange
 			p.name, // routing key
 			false,  // mandatory
 			false,  // immediate
 			amqp.Publishing{
+				Headers:     headers,
 				ContentType: "text/plain",
 				Body:        bytes,
 			})
@@ -77,3 +140,26 @@ func (p *Producer) Publish(message AMQPMessage) error {
 
 	return errPublish
 }
+
+// HeadersCarrier carries trace context in the headers of AMQP messages, so consumers
+// can continue the trace of a message with the propagator.
+type HeadersCarrier amqp.Table
+
+func (c HeadersCarrier) Get(key string) string {
+	value, _ := c[key].(string)
+
+	return value
+}
+
+func (c HeadersCarrier) Set(key string, value string) {
+	c[key] = value
+}
+
+func (c HeadersCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -1,17 +1,22 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
+	_ "time/tzdata" // time zones of rotation schedules, missing in the alpine image
 
 	simpleproducer "github.com/VladimirButakov/otus-project/internal/amqp/producer"
+	"github.com/VladimirButakov/otus-project/internal/metrics"
 	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 type App struct {
 	logger   Logger
-	storage  Storage
+	storage  TxStorage
 	bandit   Bandit
 	producer Producer
 }
@@ -25,28 +30,69 @@ type Logger interface {
 }
 
 type Storage interface {
-	AddBannerRotation(bannerID string, slotID string) error
-	RemoveBannerRotation(bannerID string, slotID string) error
-	AddClickEvent(bannerID string, slotID string, socialDemoID string, date string) error
-	AddViewEvent(bannerID string, slotID string, socialDemoID string, date string) error
-	GetNotViewedBanners(slotID string) ([]sqlstorage.NotViewedItem, error)
-	GetBannersClicks(slotID string) ([]sqlstorage.ClickItem, error)
-	GetBannersViews(slotID string) ([]sqlstorage.ViewItem, error)
-	GetBannersInSlot(slotID string) ([]sqlstorage.BannerRotationItem, error)
-	CreateBanner(ID string, description string) (string, error)
-	CreateSlot(ID string, description string) (string, error)
-	CreateSocialDemo(ID string, description string) (string, error)
+	AddBannerRotation(ctx context.Context, bannerID string, slotID string) error
+	ScheduleBannerRotation(ctx context.Context, bannerID string, slotID string, schedule sqlstorage.Schedule) error
+	RemoveBannerRotation(ctx context.Context, bannerID string, slotID string) error
+	AddClickEvent(ctx context.Context, bannerID string, slotID string, socialDemoID string, date string) error
+	AddViewEvent(ctx context.Context, bannerID string, slotID string, socialDemoID string, date string) error
+	GetNotViewedBanners(ctx context.Context, slotID string, socialDemoID string) ([]sqlstorage.NotViewedItem, error)
+	GetBannersInSlot(ctx context.Context, slotID string) ([]sqlstorage.BannerRotationItem, error)
+	GetBannersInSlots(ctx context.Context, slotIDs []string) ([]sqlstorage.BannerRotationItem, error)
+	GetNotViewedBannersInSlots(ctx context.Context, slotIDs []string, socialDemoID string) ([]sqlstorage.NotViewedItem, error)
+	GetEventCountsInSlots(ctx context.Context, slotIDs []string) ([]sqlstorage.CounterItem, error)
+	CreateBanner(ctx context.Context, ID string, description string, creative sqlstorage.Creative) (string, error)
+	CreateSlot(ctx context.Context, ID string, description string, sizes []sqlstorage.Size) (string, error)
+	CreateSocialDemo(ctx context.Context, ID string, description string) (string, error)
+	GetBanner(ctx context.Context, id string) (sqlstorage.BannerItem, error)
+	GetBanners(ctx context.Context, ids []string) ([]sqlstorage.BannerItem, error)
+	ListBanners(ctx context.Context, filter sqlstorage.ListFilter) ([]sqlstorage.BannerItem, string, error)
+	UpdateBanner(ctx context.Context, id string, banner sqlstorage.BannerItem, fields []string) (sqlstorage.BannerItem, error)
+	DeleteBanner(ctx context.Context, id string, force bool) error
+	GetSlot(ctx context.Context, id string) (sqlstorage.SlotItem, error)
+	ListSlots(ctx context.Context, filter sqlstorage.ListFilter) ([]sqlstorage.SlotItem, string, error)
+	UpdateSlot(ctx context.Context, id string, slot sqlstorage.SlotItem, fields []string) (sqlstorage.SlotItem, error)
+	GetSlotSizes(ctx context.Context, slotID string) ([]sqlstorage.Size, error)
+	DeleteSlot(ctx context.Context, id string, force bool) error
+	GetSocialDemo(ctx context.Context, id string) (sqlstorage.SocialDemoItem, error)
+	ListSocialDemos(ctx context.Context, filter sqlstorage.ListFilter) ([]sqlstorage.SocialDemoItem, string, error)
+	UpdateSocialDemo(ctx context.Context, id string, description string) error
+	DeleteSocialDemo(ctx context.Context, id string, force bool) error
+	ListRotations(ctx context.Context, filter sqlstorage.ListFilter) ([]sqlstorage.RotationItem, string, error)
+	GetStats(ctx context.Context, filter sqlstorage.StatsFilter) ([]sqlstorage.StatsItem, error)
+	AddImpression(ctx context.Context, id string, bannerID string, slotID string, socialDemoID string, userID string) error
+	ClickImpression(ctx context.Context, id string, clickedAt time.Time) (sqlstorage.ImpressionItem, error)
+	SetFrequencyCap(ctx context.Context, bannerID string, slotID string, frequencyCap sqlstorage.FrequencyCap) error
+	GetUserViews(ctx context.Context, userID string, now time.Time) ([]sqlstorage.UserViewItem, error)
+	SetTargeting(ctx context.Context, bannerID string, slotID string, targeting sqlstorage.Targeting) error
+	GetTargeting(ctx context.Context, bannerID string, slotID string) (sqlstorage.Targeting, error)
+	GetTargetingInSlots(ctx context.Context, slotIDs []string) ([]sqlstorage.TargetItem, error)
+}
+
+// TxStorage is a Storage able to run several calls as a single unit of work.
+// Storage calls made with the ctx passed to fn run in one transaction.
+type TxStorage interface {
+	Storage
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Producer interface {
-	Publish(message simpleproducer.AMQPMessage) error
+	Publish(ctx context.Context, message simpleproducer.AMQPMessage) error
 }
 
 type Bandit interface {
 	Use(items []string, clicks map[string]int, views map[string]int) (string, error)
 }
 
-func New(logger Logger, storage Storage, bandit Bandit, producer Producer) *App {
+var (
+	ErrImpressionMismatch = errors.New("click does not match the impression")
+	ErrSizeNotAccepted    = errors.New("banner size is not accepted by the slot")
+	ErrInvalidSchedule    = errors.New("invalid schedule")
+	ErrNotInRotation      = errors.New("banner is not in rotation of the slot")
+	ErrNoEligibleBanner   = errors.New("no banner in rotation is eligible for the social demo group and user")
+	ErrEmptySlot          = errors.New("slot has no banners in rotation")
+)
+
+func New(logger Logger, storage TxStorage, bandit Bandit, producer Producer) *App {
 	return &App{logger, storage, bandit, producer}
 }
 
@@ -54,23 +100,126 @@ func (a *App) GetLogger() Logger {
 	return a.logger
 }
 
-func (a *App) AddBannerRotation(bannerID string, slotID string) error {
-	return a.storage.AddBannerRotation(bannerID, slotID)
+// AddBannerRotation adds a banner to the slot if the slot accepts its size. With
+// ifNotExists, adding a banner which is already in rotation succeeds.
+func (a *App) AddBannerRotation(ctx context.Context, bannerID string, slotID string, ifNotExists bool) error {
+	err := a.storage.WithTx(ctx, func(ctx context.Context) error {
+		if err := a.checkSize(ctx, bannerID, slotID); err != nil {
+			return err
+		}
+
+		return a.storage.AddBannerRotation(ctx, bannerID, slotID)
+	})
+	if ifNotExists && errors.Is(err, sqlstorage.ErrAlreadyExists) {
+		return nil
+	}
+
+	return err
 }
 
-func (a *App) RemoveBannerRotation(bannerID string, slotID string) error {
-	return a.storage.RemoveBannerRotation(bannerID, slotID)
+// ScheduleBannerRotation adds a banner to the slot for the scheduled time range, or
+// changes the schedule of a banner already in rotation. The bounds are wall-clock
+// times of the time zone, UTC by default, written as UTC, so a campaign starting at
+// midnight of the time zone is scheduled with starts_at at midnight UTC.
+func (a *App) ScheduleBannerRotation(ctx context.Context, bannerID string, slotID string, schedule sqlstorage.Schedule) error {
+	if schedule.TimeZone == "" {
+		schedule.TimeZone = "UTC"
+	}
+
+	location, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return invalid(ErrInvalidSchedule, "time_zone", err.Error())
+	}
+
+	if !schedule.StartsAt.IsZero() && !schedule.EndsAt.IsZero() && !schedule.EndsAt.After(schedule.StartsAt) {
+		return invalid(ErrInvalidSchedule, "ends_at", "must be after starts_at")
+	}
+
+	schedule.StartsAt = fromWallClock(schedule.StartsAt, location)
+	schedule.EndsAt = fromWallClock(schedule.EndsAt, location)
+
+	return a.storage.WithTx(ctx, func(ctx context.Context) error {
+		if err := a.checkSize(ctx, bannerID, slotID); err != nil {
+			return err
+		}
+
+		return a.storage.ScheduleBannerRotation(ctx, bannerID, slotID, schedule)
+	})
 }
 
-func (a *App) AddClickEvent(bannerID string, slotID string, socialDemoID string) error {
-	date := time.Now().String()
+// checkSize rejects a banner which does not fit the slot. Slots without sizes accept any banner.
+func (a *App) checkSize(ctx context.Context, bannerID string, slotID string) error {
+	sizes, err := a.storage.GetSlotSizes(ctx, slotID)
+	if err != nil || len(sizes) == 0 {
+		return err
+	}
 
-	err := a.storage.AddClickEvent(bannerID, slotID, socialDemoID, date)
+	banner, err := a.storage.GetBanner(ctx, bannerID)
+	if errors.Is(err, sqlstorage.ErrNotFound) {
+		// The insert reports the unknown banner.
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, size := range sizes {
+		if size.Width == banner.Width && size.Height == banner.Height {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot add banner %s of size %dx%d to slot %s, %w", bannerID, banner.Width, banner.Height, slotID, ErrSizeNotAccepted)
+}
+
+func (a *App) RemoveBannerRotation(ctx context.Context, bannerID string, slotID string) error {
+	return a.storage.RemoveBannerRotation(ctx, bannerID, slotID)
+}
+
+// AddClickEvent records a click. With an impression ID, the impression is marked
+// as clicked, which is allowed once, and ids left empty are taken from it.
+// The user is only checked against the impression. Without it, the banner must be
+// in rotation of the slot.
+func (a *App) AddClickEvent(
+	ctx context.Context,
+	bannerID string,
+	slotID string,
+	socialDemoID string,
+	userID string,
+	impressionID string,
+) error {
+	now := time.Now()
+	date := now.String()
+
+	err := a.storage.WithTx(ctx, func(ctx context.Context) error {
+		if impressionID != "" {
+			impression, err := a.storage.ClickImpression(ctx, impressionID, now)
+			if err != nil {
+				return err
+			}
+
+			if !matches(bannerID, impression.BannerID) || !matches(slotID, impression.SlotID) ||
+				!matches(socialDemoID, impression.SocialDemoID) || !matches(userID, impression.UserID) {
+				return fmt.Errorf("cannot click impression %s, %w", impressionID, ErrImpressionMismatch)
+			}
+
+			bannerID, slotID, socialDemoID = impression.BannerID, impression.SlotID, impression.SocialDemoID
+		} else if err := a.checkRotation(ctx, bannerID, slotID); err != nil {
+			return err
+		}
+
+		return a.storage.AddClickEvent(ctx, bannerID, slotID, socialDemoID, date)
+	})
 	if err != nil {
 		return fmt.Errorf("cannot create banner click event, %w", err)
 	}
 
-	err = a.producer.Publish(simpleproducer.AMQPMessage{Type: "click", SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date})
+	// The slot is validated by the impression or the rotation check above, so
+	// clients cannot add series with arbitrary slot ids.
+	metrics.Clicks.WithLabelValues(slotID).Inc()
+
+	err = a.producer.Publish(ctx, simpleproducer.AMQPMessage{Type: "click", SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date})
 	if err != nil {
 		return fmt.Errorf("cannot publish banner click, %w", err)
 	}
@@ -78,26 +227,46 @@ func (a *App) AddClickEvent(bannerID string, slotID string, socialDemoID string)
 	return nil
 }
 
-func (a *App) AddViewEvent(bannerID string, slotID string, socialDemoID string) error {
+func (a *App) checkRotation(ctx context.Context, bannerID string, slotID string) error {
+	rotation, err := a.storage.GetBannersInSlot(ctx, slotID)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range rotation {
+		if item.BannerID == bannerID {
+			return nil
+		}
+	}
+
+	return invalid(ErrNotInRotation, "banner_id", "is not in rotation of the slot")
+}
+
+func matches(id string, impressionID string) bool {
+	return id == "" || id == impressionID
+}
+
+func (a *App) AddViewEvent(ctx context.Context, bannerID string, slotID string, socialDemoID string) error {
 	date := time.Now().String()
 
-	err := a.storage.AddViewEvent(bannerID, slotID, socialDemoID, date)
+	err := a.storage.AddViewEvent(ctx, bannerID, slotID, socialDemoID, date)
 	if err != nil {
 		return fmt.Errorf("cannot create banner view event, %w", err)
 	}
 
-	err = a.producer.Publish(simpleproducer.AMQPMessage{Type: "view", SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date})
+	err = a.producer.Publish(ctx, simpleproducer.AMQPMessage{Type: "view", SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date})
 	if err != nil {
-		return fmt.Errorf("cannot publish banner click, %w", err)
+		return fmt.Errorf("cannot publish banner view, %w", err)
 	}
 
 	return nil
 }
 
+// MapDataFromDB returns the banners in rotation with their clicks and views, counted
+// by the database.
 func (a *App) MapDataFromDB(
 	bannersInSlot []sqlstorage.BannerRotationItem,
-	bannersClicks []sqlstorage.ClickItem,
-	bannersViews []sqlstorage.ViewItem) (
+	counters []sqlstorage.CounterItem) (
 	banners []string,
 	mappedBannersClicks map[string]int,
 	mappedBannersViews map[string]int,
@@ -109,71 +278,310 @@ func (a *App) MapDataFromDB(
 		banners = append(banners, banner.BannerID)
 	}
 
-	for _, click := range bannersClicks {
-		mappedBannersClicks[click.BannerID]++
-	}
+	for _, counter := range counters {
+		if counter.Clicks > 0 {
+			mappedBannersClicks[counter.BannerID] += counter.Clicks
+		}
 
-	for _, view := range bannersViews {
-		mappedBannersViews[view.BannerID]++
+		if counter.Views > 0 {
+			mappedBannersViews[counter.BannerID] += counter.Views
+		}
 	}
 
 	return banners, mappedBannersClicks, mappedBannersViews
 }
 
-func (a *App) GetBanner(slotID string, socialDemoID string) (string, error) {
-	notViewedBanners, err := a.storage.GetNotViewedBanners(slotID)
-	if err != nil {
-		return "", err
-	}
+// GetBanner selects a banner for the slot and records its view in a single transaction,
+// so the choice is made on a consistent snapshot of the rotation and events.
+// It returns the banner with its creative and the ID of its impression, which clicks refer to.
+// Banners the user has reached the frequency cap of are not shown, unless the user is anonymous.
+func (a *App) GetBanner(
+	ctx context.Context,
+	slotID string,
+	socialDemoID string,
+	userID string,
+) (banner sqlstorage.BannerItem, impressionID string, err error) {
+	date := time.Now().String()
+
+	var path string
+
+	err = a.storage.WithTx(ctx, func(ctx context.Context) error {
+		bannerID, selectionPath, err := a.selectBanner(ctx, slotID, socialDemoID, userID)
+		if err != nil {
+			return err
+		}
 
-	if len(notViewedBanners) > 0 {
-		bannerID := notViewedBanners[0].BannerID
+		path = selectionPath
 
-		err := a.AddViewEvent(bannerID, slotID, socialDemoID)
+		banner, err = a.storage.GetBanner(ctx, bannerID)
 		if err != nil {
-			return "", err
+			return err
 		}
 
-		return bannerID, nil
+		impressionID, err = a.addView(ctx, bannerID, slotID, socialDemoID, userID, date)
+
+		return err
+	})
+	if err != nil {
+		return sqlstorage.BannerItem{}, "", err
 	}
 
-	bannersInSlot, err := a.storage.GetBannersInSlot(slotID)
+	metrics.Selections.WithLabelValues(slotID, path).Inc()
+
+	err = a.producer.Publish(ctx, simpleproducer.AMQPMessage{Type: "view", SlotID: slotID, BannerID: banner.ID, SocialDemoID: socialDemoID, Date: date})
 	if err != nil {
-		return "", err
+		return sqlstorage.BannerItem{}, "", fmt.Errorf("cannot publish banner view, %w", err)
 	}
 
-	bannersClicks, err := a.storage.GetBannersClicks(slotID)
+	return banner, impressionID, nil
+}
+
+// addView records the view event and the impression of a shown banner.
+func (a *App) addView(ctx context.Context, bannerID string, slotID string, socialDemoID string, userID string, date string) (string, error) {
+	err := a.storage.AddViewEvent(ctx, bannerID, slotID, socialDemoID, date)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("cannot create banner view event, %w", err)
 	}
 
-	bannersViews, err := a.storage.GetBannersViews(slotID)
+	impressionID := uuid.NewString()
+
+	err = a.storage.AddImpression(ctx, impressionID, bannerID, slotID, socialDemoID, userID)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("cannot create banner impression, %w", err)
+	}
+
+	return impressionID, nil
+}
+
+// selectBanner returns a banner the social demo group has not seen yet, if any,
+// otherwise the one chosen by the bandit, with the selection path taken. Banners
+// not targeted at the social demo group or capped for the user are skipped.
+func (a *App) selectBanner(ctx context.Context, slotID string, socialDemoID string, userID string) (string, string, error) {
+	rotation, err := a.storage.GetBannersInSlot(ctx, slotID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(rotation) == 0 {
+		return "", "", fmt.Errorf("cannot select banner for slot %s, %w", slotID, ErrEmptySlot)
+	}
+
+	rules, err := a.getTargeting(ctx, []string{slotID})
+	if err != nil {
+		return "", "", err
+	}
+
+	views, err := a.getUserViews(ctx, userID)
+	if err != nil {
+		return "", "", err
 	}
 
-	banners, mappedBannersClicks, mappedBannersViews := a.MapDataFromDB(bannersInSlot, bannersClicks, bannersViews)
+	bannersInSlot, allowed := views.uncapped(rules.eligible(rotation, socialDemoID))
+	if len(bannersInSlot) == 0 {
+		return "", "", fmt.Errorf("cannot select banner for slot %s, %w", slotID, ErrNoEligibleBanner)
+	}
+
+	notViewedBanners, err := a.storage.GetNotViewedBanners(ctx, slotID, socialDemoID)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, item := range notViewedBanners {
+		if allowed[item.BannerID] {
+			return item.BannerID, metrics.PathUnseen, nil
+		}
+	}
+
+	counters, err := a.storage.GetEventCountsInSlots(ctx, []string{slotID})
+	if err != nil {
+		return "", "", err
+	}
+
+	banners, mappedBannersClicks, mappedBannersViews := a.MapDataFromDB(bannersInSlot, counters)
+
 	bannerID, err := a.bandit.Use(banners, mappedBannersClicks, mappedBannersViews)
+
+	return bannerID, metrics.PathUCB, err
+}
+
+// existing drops the already exists error of a create with ifNotExists when the
+// stored entity is the same as the created one, so retried creates succeed.
+func existing(err error, ifNotExists bool, same func() (bool, error)) error {
+	if !ifNotExists || !errors.Is(err, sqlstorage.ErrAlreadyExists) {
+		return err
+	}
+
+	isSame, getErr := same()
+	if getErr != nil {
+		return getErr
+	}
+
+	if !isSame {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) CreateBanner(ctx context.Context, id string, description string, creative sqlstorage.Creative, ifNotExists bool) (string, error) {
+	_, err := a.storage.CreateBanner(ctx, id, description, creative)
+
+	err = existing(err, ifNotExists, func() (bool, error) {
+		banner, err := a.storage.GetBanner(ctx, id)
+
+		return banner.Description == description && banner.Creative == creative, err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (a *App) CreateSlot(ctx context.Context, id string, description string, sizes []sqlstorage.Size, ifNotExists bool) (string, error) {
+	_, err := a.storage.CreateSlot(ctx, id, description, sizes)
+
+	err = existing(err, ifNotExists, func() (bool, error) {
+		slot, err := a.storage.GetSlot(ctx, id)
+
+		return slot.Description == description && sameSizes(slot.Sizes, sizes), err
+	})
 	if err != nil {
 		return "", err
 	}
 
-	err = a.AddViewEvent(bannerID, slotID, socialDemoID)
+	return id, nil
+}
+
+func (a *App) CreateSocialDemo(ctx context.Context, id string, description string, ifNotExists bool) (string, error) {
+	_, err := a.storage.CreateSocialDemo(ctx, id, description)
+
+	err = existing(err, ifNotExists, func() (bool, error) {
+		socialDemo, err := a.storage.GetSocialDemo(ctx, id)
+
+		return socialDemo.Description == description, err
+	})
 	if err != nil {
 		return "", err
 	}
 
-	return bannerID, nil
+	return id, nil
 }
 
-func (a *App) CreateBanner(id string, description string) (string, error) {
-	return a.storage.CreateBanner(id, description)
+// sameSizes compares sizes regardless of order and repeats.
+func sameSizes(a []sqlstorage.Size, b []sqlstorage.Size) bool {
+	set := make(map[sqlstorage.Size]bool, len(a))
+	for _, size := range a {
+		set[size] = true
+	}
+
+	other := make(map[sqlstorage.Size]bool, len(b))
+	for _, size := range b {
+		if !set[size] {
+			return false
+		}
+
+		other[size] = true
+	}
+
+	return len(set) == len(other)
 }
 
-func (a *App) CreateSlot(id string, description string) (string, error) {
-	return a.storage.CreateSlot(id, description)
+func (a *App) ReadBanner(ctx context.Context, id string) (sqlstorage.BannerItem, error) {
+	return a.storage.GetBanner(ctx, id)
 }
 
-func (a *App) CreateSocialDemo(id string, description string) (string, error) {
-	return a.storage.CreateSocialDemo(id, description)
+func (a *App) ListBanners(ctx context.Context, filter sqlstorage.ListFilter) ([]sqlstorage.BannerItem, string, error) {
+	return a.storage.ListBanners(ctx, filter)
+}
+
+// UpdateBanner sets the fields of a banner, leaving the other fields as they are.
+func (a *App) UpdateBanner(
+	ctx context.Context,
+	id string,
+	banner sqlstorage.BannerItem,
+	fields []string,
+) (sqlstorage.BannerItem, error) {
+	return a.storage.UpdateBanner(ctx, id, banner, fields)
+}
+
+func (a *App) DeleteBanner(ctx context.Context, id string, force bool) error {
+	return a.storage.DeleteBanner(ctx, id, force)
+}
+
+func (a *App) ReadSlot(ctx context.Context, id string) (sqlstorage.SlotItem, error) {
+	return a.storage.GetSlot(ctx, id)
+}
+
+func (a *App) ListSlots(ctx context.Context, filter sqlstorage.ListFilter) ([]sqlstorage.SlotItem, string, error) {
+	return a.storage.ListSlots(ctx, filter)
+}
+
+// UpdateSlot sets the fields of a slot, leaving the other fields as they are.
+func (a *App) UpdateSlot(ctx context.Context, id string, slot sqlstorage.SlotItem, fields []string) (sqlstorage.SlotItem, error) {
+	return a.storage.UpdateSlot(ctx, id, slot, fields)
+}
+
+func (a *App) DeleteSlot(ctx context.Context, id string, force bool) error {
+	return a.storage.DeleteSlot(ctx, id, force)
+}
+
+func (a *App) ReadSocialDemo(ctx context.Context, id string) (sqlstorage.SocialDemoItem, error) {
+	return a.storage.GetSocialDemo(ctx, id)
+}
+
+func (a *App) ListSocialDemos(ctx context.Context, filter sqlstorage.ListFilter) ([]sqlstorage.SocialDemoItem, string, error) {
+	return a.storage.ListSocialDemos(ctx, filter)
+}
+
+func (a *App) UpdateSocialDemo(ctx context.Context, id string, description string) error {
+	return a.storage.UpdateSocialDemo(ctx, id, description)
+}
+
+func (a *App) DeleteSocialDemo(ctx context.Context, id string, force bool) error {
+	return a.storage.DeleteSocialDemo(ctx, id, force)
+}
+
+// ListRotations returns a page of rotation entries with their schedules as wall-clock
+// times of their time zones, as they were scheduled.
+func (a *App) ListRotations(ctx context.Context, filter sqlstorage.ListFilter) ([]sqlstorage.RotationItem, string, error) {
+	rotations, next, err := a.storage.ListRotations(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i := range rotations {
+		location, err := time.LoadLocation(rotations[i].TimeZone)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot load time zone of rotation, %w", err)
+		}
+
+		rotations[i].StartsAt.Time = toWallClock(rotations[i].StartsAt.Time, location)
+		rotations[i].EndsAt.Time = toWallClock(rotations[i].EndsAt.Time, location)
+	}
+
+	return rotations, next, nil
+}
+
+// fromWallClock returns the instant at which clocks of the location show the date
+// and time of t in UTC. Stored schedules are instants, so they are compared with now.
+func fromWallClock(t time.Time, location *time.Location) time.Time {
+	if t.IsZero() {
+		return t
+	}
+
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location)
+}
+
+// toWallClock returns the date and time which clocks of the location show at t, in UTC.
+func toWallClock(t time.Time, location *time.Location) time.Time {
+	if t.IsZero() {
+		return t
+	}
+
+	t = t.In(location)
+
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
 }
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	simpleproducer "github.com/VladimirButakov/otus-project/internal/amqp/producer"
+	"github.com/VladimirButakov/otus-project/internal/bandit"
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	sqlitestorage "github.com/VladimirButakov/otus-project/internal/storage/sqlite"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type logger struct{}
+
+func (l logger) Info(msg string, keysAndValues ...interface{})  {}
+func (l logger) Warn(msg string, keysAndValues ...interface{})  {}
+func (l logger) Debug(msg string, keysAndValues ...interface{}) {}
+func (l logger) Error(msg string, keysAndValues ...interface{}) {}
+func (l logger) GetInstance() *zap.Logger                       { return zap.NewNop() }
+
+type producer struct {
+	messages []simpleproducer.AMQPMessage
+}
+
+func (p *producer) Publish(ctx context.Context, message simpleproducer.AMQPMessage) error {
+	p.messages = append(p.messages, message)
+
+	return nil
+}
+
+// newTestApp returns an app on an in-memory SQLite storage, closed when the test ends,
+// with the storage and the producer of its events.
+func newTestApp(t *testing.T) (*App, *sqlstorage.Storage, *producer) {
+	t.Helper()
+
+	storage, err := sqlitestorage.New(context.Background(), ":memory:", time.Second)
+	require.NoError(t, err, "should be without errors")
+
+	t.Cleanup(func() { storage.Close() })
+
+	p := &producer{}
+
+	return New(logger{}, storage, &bandit.Bandit{}, p), storage, p
+}
+
+func TestAddBannerRotationSizes(t *testing.T) {
+	ctx := context.Background()
+	a, _, _ := newTestApp(t)
+
+	_, err := a.CreateSlot(ctx, "slot1", "", []sqlstorage.Size{{Width: 300, Height: 250}}, false)
+	require.NoError(t, err, "should be without errors")
+
+	_, err = a.CreateSlot(ctx, "slot2", "", nil, false)
+	require.NoError(t, err, "should be without errors")
+
+	_, err = a.CreateBanner(ctx, "fits", "", sqlstorage.Creative{Width: 300, Height: 250}, false)
+	require.NoError(t, err, "should be without errors")
+
+	_, err = a.CreateBanner(ctx, "wide", "", sqlstorage.Creative{Width: 728, Height: 90}, false)
+	require.NoError(t, err, "should be without errors")
+
+	require.NoError(t, a.AddBannerRotation(ctx, "fits", "slot1", false), "should be without errors")
+	require.ErrorIs(t, a.AddBannerRotation(ctx, "wide", "slot1", false), ErrSizeNotAccepted)
+	require.NoError(t, a.AddBannerRotation(ctx, "wide", "slot2", false), "slot without sizes should accept any banner")
+	require.ErrorIs(t, a.AddBannerRotation(ctx, "unknown", "slot1", false), sqlstorage.ErrForeignKeyViolation)
+
+	banner, _, err := a.GetBanner(ctx, "slot1", "social_demo1", "")
+	require.NoError(t, err, "should be without errors")
+	require.Equal(t, "fits", banner.ID)
+	require.Equal(t, 300, banner.Width, "creative should be returned")
+
+	_, err = a.CreateSlot(ctx, "slot1", "", []sqlstorage.Size{{Width: 300, Height: 250}, {Width: 300, Height: 250}}, true)
+	require.NoError(t, err, "create of the same slot should succeed")
+
+	_, err = a.CreateSlot(ctx, "slot1", "", nil, true)
+	require.ErrorIs(t, err, sqlstorage.ErrAlreadyExists)
+}
+
+func TestScheduleBannerRotation(t *testing.T) {
+	ctx := context.Background()
+	a, _, _ := newTestApp(t)
+
+	_, err := a.CreateSlot(ctx, "slot1", "", nil, false)
+	require.NoError(t, err, "should be without errors")
+
+	for _, id := range []string{"current", "future", "ended"} {
+		_, err = a.CreateBanner(ctx, id, "", sqlstorage.Creative{}, false)
+		require.NoError(t, err, "should be without errors")
+	}
+
+	now := time.Now()
+
+	// Moscow clocks are 3 hours ahead of UTC, so its wall-clock time 2 hours from now
+	// in UTC is an hour ago.
+	err = a.ScheduleBannerRotation(ctx, "current", "slot1", sqlstorage.Schedule{EndsAt: now.Add(2 * time.Hour), TimeZone: "Europe/Moscow"})
+	require.NoError(t, err, "should be without errors")
+
+	_, _, err = a.GetBanner(ctx, "slot1", "social_demo1", "")
+	require.ErrorIs(t, err, ErrEmptySlot, "schedule should be resolved in its time zone")
+
+	moscowNow := now.In(time.FixedZone("MSK", 3*60*60))
+	endsAt := time.Date(moscowNow.Year(), moscowNow.Month(), moscowNow.Day(), moscowNow.Hour()+2, 0, 0, 0, time.UTC)
+
+	err = a.ScheduleBannerRotation(ctx, "current", "slot1", sqlstorage.Schedule{EndsAt: endsAt, TimeZone: "Europe/Moscow"})
+	require.NoError(t, err, "should be without errors")
+
+	err = a.ScheduleBannerRotation(ctx, "future", "slot1", sqlstorage.Schedule{StartsAt: now.Add(time.Hour)})
+	require.NoError(t, err, "should be without errors")
+
+	require.NoError(t, a.AddBannerRotation(ctx, "ended", "slot1", false), "should be without errors")
+
+	err = a.ScheduleBannerRotation(ctx, "ended", "slot1", sqlstorage.Schedule{EndsAt: now.Add(-time.Hour)})
+	require.NoError(t, err, "rotation should be rescheduled")
+
+	for i := 0; i < 3; i++ {
+		banner, _, err := a.GetBanner(ctx, "slot1", "social_demo1", "")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, "current", banner.ID, "only active banners should be shown")
+	}
+
+	rotations, _, err := a.ListRotations(ctx, sqlstorage.ListFilter{SlotID: "slot1"})
+	require.NoError(t, err, "should be without errors")
+	require.Len(t, rotations, 3, "scheduled rotations should be listed")
+	require.Equal(t, "Europe/Moscow", rotations[0].TimeZone)
+	require.True(t, rotations[0].EndsAt.Valid, "end should be stored")
+	require.True(t, endsAt.Equal(rotations[0].EndsAt.Time), "end should be listed as scheduled")
+	require.False(t, rotations[0].StartsAt.Valid, "start should be open")
+
+	banners, _, err := a.ListBanners(ctx, sqlstorage.ListFilter{Status: sqlstorage.StatusActive})
+	require.NoError(t, err, "should be without errors")
+	require.Len(t, banners, 1, "only banners of active rotation entries should be active")
+	require.Equal(t, "current", banners[0].ID)
+
+	banners, _, err = a.ListBanners(ctx, sqlstorage.ListFilter{Status: sqlstorage.StatusInactive})
+	require.NoError(t, err, "should be without errors")
+	require.Len(t, banners, 2, "banners of scheduled and ended rotation entries should be inactive")
+
+	err = a.ScheduleBannerRotation(ctx, "future", "slot1", sqlstorage.Schedule{StartsAt: now, EndsAt: now})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	err = a.ScheduleBannerRotation(ctx, "future", "slot1", sqlstorage.Schedule{TimeZone: "Mars/Olympus"})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+}
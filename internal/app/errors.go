@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+)
+
+// Code classifies domain errors. Transports map it to their own status codes.
+type Code int
+
+const (
+	CodeInternal Code = iota
+	CodeInvalidArgument
+	CodeNotFound
+	CodeAlreadyExists
+	CodeFailedPrecondition
+	CodeUnavailable
+	CodeDeadlineExceeded
+	CodeCanceled
+)
+
+// FieldViolation describes an invalid field of a request.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is a domain error which is safe to show to clients. Reason is a stable
+// identifier of the error, Message is its text without internal details, which
+// are kept in the wrapped cause.
+type Error struct {
+	Code       Code
+	Reason     string
+	Message    string
+	Violations []FieldViolation
+	cause      error
+}
+
+func (e *Error) Error() string {
+	if e.cause == nil {
+		return e.Message
+	}
+
+	return e.cause.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// catalogue lists the domain errors clients may get. Errors not listed are internal.
+var catalogue = []struct {
+	err    error
+	code   Code
+	reason string
+}{
+	{sqlstorage.ErrNotFound, CodeNotFound, "NOT_FOUND"},
+	{sqlstorage.ErrBannersWereRemoved, CodeNotFound, "NOT_IN_ROTATION"},
+	{ErrNoEligibleBanner, CodeNotFound, "NO_ELIGIBLE_BANNER"},
+	{ErrEmptySlot, CodeNotFound, "EMPTY_SLOT"},
+	{sqlstorage.ErrAlreadyExists, CodeAlreadyExists, "ALREADY_EXISTS"},
+	{sqlstorage.ErrAlreadyClicked, CodeAlreadyExists, "ALREADY_CLICKED"},
+	{sqlstorage.ErrInRotation, CodeFailedPrecondition, "IN_ROTATION"},
+	{sqlstorage.ErrForeignKeyViolation, CodeFailedPrecondition, "REFERENCED_ENTITY_NOT_FOUND"},
+	{ErrSizeNotAccepted, CodeFailedPrecondition, "SIZE_NOT_ACCEPTED"},
+	{sqlstorage.ErrInvalidPageToken, CodeInvalidArgument, "INVALID_PAGE_TOKEN"},
+	{sqlstorage.ErrUnsupportedFilter, CodeInvalidArgument, "UNSUPPORTED_FILTER"},
+	{sqlstorage.ErrUnknownField, CodeInvalidArgument, "UNKNOWN_FIELD"},
+	{sqlstorage.ErrTargetingConflict, CodeInvalidArgument, "TARGETING_CONFLICT"},
+	{ErrImpressionMismatch, CodeInvalidArgument, "IMPRESSION_MISMATCH"},
+	{ErrInvalidSchedule, CodeInvalidArgument, "INVALID_SCHEDULE"},
+	{ErrNotInRotation, CodeInvalidArgument, "BANNER_NOT_IN_ROTATION"},
+}
+
+// Classify returns the domain error of err. Errors which are not in the catalogue
+// are internal, or unavailable when the storage cannot be reached, and their
+// message does not reveal the cause.
+func Classify(err error) *Error {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return &Error{Code: CodeDeadlineExceeded, Reason: "DEADLINE_EXCEEDED", Message: "deadline exceeded", cause: err}
+	case errors.Is(err, context.Canceled):
+		return &Error{Code: CodeCanceled, Reason: "CANCELED", Message: "canceled", cause: err}
+	}
+
+	for _, item := range catalogue {
+		if errors.Is(err, item.err) {
+			return &Error{Code: item.code, Reason: item.reason, Message: item.err.Error(), cause: err}
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return &Error{Code: CodeUnavailable, Reason: "UNAVAILABLE", Message: "service is unavailable", cause: err}
+	}
+
+	return &Error{Code: CodeInternal, Reason: "INTERNAL", Message: "internal error", cause: err}
+}
+
+// Violations collects invalid fields of a request.
+type Violations []FieldViolation
+
+func (v *Violations) Add(field string, description string) {
+	*v = append(*v, FieldViolation{Field: field, Description: description})
+}
+
+// Required adds a violation when the value of the field is empty.
+func (v *Violations) Required(field string, value string) {
+	if value == "" {
+		v.Add(field, "is required")
+	}
+}
+
+// Err returns the invalid argument error of the violations, or nil when there are none.
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	return &Error{Code: CodeInvalidArgument, Reason: "INVALID_ARGUMENT", Message: "invalid request", Violations: v}
+}
+
+// invalid returns the invalid argument error of the field wrapping cause, so it
+// still matches cause with errors.Is.
+func invalid(cause error, field string, description string) error {
+	reason := "INVALID_ARGUMENT"
+
+	for _, item := range catalogue {
+		if item.err == cause {
+			reason = item.reason
+		}
+	}
+
+	return &Error{
+		Code:       CodeInvalidArgument,
+		Reason:     reason,
+		Message:    cause.Error(),
+		Violations: []FieldViolation{{Field: field, Description: description}},
+		cause:      cause,
+	}
+}
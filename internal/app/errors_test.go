@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	t.Run("test catalogue errors", func(t *testing.T) {
+		for _, item := range []struct {
+			err    error
+			code   Code
+			reason string
+		}{
+			{fmt.Errorf("cannot get banner b1, %w", sqlstorage.ErrNotFound), CodeNotFound, "NOT_FOUND"},
+			{fmt.Errorf("cannot select banner for slot s1, %w", ErrNoEligibleBanner), CodeNotFound, "NO_ELIGIBLE_BANNER"},
+			{fmt.Errorf("cannot create slot, %w", sqlstorage.ErrAlreadyExists), CodeAlreadyExists, "ALREADY_EXISTS"},
+			{fmt.Errorf("cannot delete slot, %w", sqlstorage.ErrInRotation), CodeFailedPrecondition, "IN_ROTATION"},
+			{fmt.Errorf("cannot list, %w", sqlstorage.ErrInvalidPageToken), CodeInvalidArgument, "INVALID_PAGE_TOKEN"},
+			{fmt.Errorf("cannot get banners, %w", context.DeadlineExceeded), CodeDeadlineExceeded, "DEADLINE_EXCEEDED"},
+		} {
+			domainErr := Classify(item.err)
+			require.Equal(t, item.code, domainErr.Code, "code should be same")
+			require.Equal(t, item.reason, domainErr.Reason, "reason should be same")
+			require.ErrorIs(t, domainErr, item.err)
+		}
+	})
+
+	t.Run("test internal errors are hidden", func(t *testing.T) {
+		err := fmt.Errorf("cannot get banners, %w", errors.New(`pq: relation "banners" does not exist`))
+
+		domainErr := Classify(err)
+		require.Equal(t, CodeInternal, domainErr.Code, "unknown errors should be internal")
+		require.NotContains(t, domainErr.Message, "pq:", "cause should not be shown")
+
+		err = fmt.Errorf("cannot get banners, %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+		require.Equal(t, CodeUnavailable, Classify(err).Code, "network errors should be unavailable")
+	})
+
+	t.Run("test violations", func(t *testing.T) {
+		var violations Violations
+
+		require.NoError(t, violations.Err(), "should be without errors")
+
+		violations.Required("slot_id", "")
+		violations.Required("banner_id", "b1")
+
+		domainErr := Classify(fmt.Errorf("cannot add banner, %w", violations.Err()))
+		require.Equal(t, CodeInvalidArgument, domainErr.Code, "code should be same")
+		require.Equal(t, []FieldViolation{{Field: "slot_id", Description: "is required"}}, domainErr.Violations)
+
+		domainErr = Classify(invalid(ErrInvalidSchedule, "ends_at", "must be after starts_at"))
+		require.Equal(t, "INVALID_SCHEDULE", domainErr.Reason, "reason of cause should be used")
+		require.ErrorIs(t, domainErr, ErrInvalidSchedule)
+	})
+}
@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+)
+
+// rotationKey identifies a rotation entry, a banner in a slot.
+type rotationKey struct {
+	slotID   string
+	bannerID string
+}
+
+// userViews holds the recent views of banners shown to a user, by rotation entry,
+// as caps are. It is nil for anonymous users, who are not capped.
+type userViews map[rotationKey]sqlstorage.UserViewItem
+
+func (a *App) getUserViews(ctx context.Context, userID string) (userViews, error) {
+	if userID == "" {
+		return nil, nil
+	}
+
+	items, err := a.storage.GetUserViews(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	views := make(userViews, len(items))
+	for _, item := range items {
+		views[rotationKey{item.SlotID, item.BannerID}] = item
+	}
+
+	return views, nil
+}
+
+// capped reports whether the user reached the frequency cap of the rotation entry.
+func (v userViews) capped(item sqlstorage.BannerRotationItem) bool {
+	views := v[rotationKey{item.SlotID, item.BannerID}]
+
+	return (item.PerHour > 0 && views.HourViews >= item.PerHour) || (item.PerDay > 0 && views.DayViews >= item.PerDay)
+}
+
+// add counts a view of the banner in the slot, so banners selected for a page are capped too.
+func (v userViews) add(slotID string, bannerID string) {
+	if v == nil {
+		return
+	}
+
+	key := rotationKey{slotID, bannerID}
+
+	views := v[key]
+	views.HourViews++
+	views.DayViews++
+	v[key] = views
+}
+
+// uncapped returns the rotation entries the user may be shown and a set of their banners.
+func (v userViews) uncapped(items []sqlstorage.BannerRotationItem) ([]sqlstorage.BannerRotationItem, map[string]bool) {
+	allowed := make([]sqlstorage.BannerRotationItem, 0, len(items))
+	set := make(map[string]bool, len(items))
+
+	for _, item := range items {
+		if !v.capped(item) {
+			allowed = append(allowed, item)
+			set[item.BannerID] = true
+		}
+	}
+
+	return allowed, set
+}
+
+// SetFrequencyCap sets how many times a user may be shown the banner in the slot.
+func (a *App) SetFrequencyCap(ctx context.Context, bannerID string, slotID string, frequencyCap sqlstorage.FrequencyCap) error {
+	return a.storage.SetFrequencyCap(ctx, bannerID, slotID, frequencyCap)
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrequencyCap(t *testing.T) {
+	ctx := context.Background()
+	a, _, _ := newTestApp(t)
+
+	_, err := a.CreateSlot(ctx, "slot1", "", nil, false)
+	require.NoError(t, err, "should be without errors")
+
+	_, err = a.CreateBanner(ctx, "banner1", "", sqlstorage.Creative{}, false)
+	require.NoError(t, err, "should be without errors")
+
+	require.NoError(t, a.AddBannerRotation(ctx, "banner1", "slot1", false), "should be without errors")
+
+	err = a.SetFrequencyCap(ctx, "banner1", "slot1", sqlstorage.FrequencyCap{PerHour: 2})
+	require.NoError(t, err, "should be without errors")
+
+	err = a.SetFrequencyCap(ctx, "banner1", "unknown", sqlstorage.FrequencyCap{PerHour: 2})
+	require.ErrorIs(t, err, sqlstorage.ErrNotFound)
+
+	t.Run("test capped user", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			banner, _, err := a.GetBanner(ctx, "slot1", "social_demo1", "user1")
+			require.NoError(t, err, "should be without errors")
+			require.Equal(t, "banner1", banner.ID)
+		}
+
+		_, _, err := a.GetBanner(ctx, "slot1", "social_demo1", "user1")
+		require.ErrorIs(t, err, ErrNoEligibleBanner, "capped banner should not be shown")
+
+		_, _, err = a.GetBanner(ctx, "slot1", "social_demo1", "user2")
+		require.NoError(t, err, "other users should not be capped")
+
+		_, _, err = a.GetBanner(ctx, "slot1", "social_demo1", "")
+		require.NoError(t, err, "anonymous users should not be capped")
+	})
+
+	t.Run("test caps are per slot", func(t *testing.T) {
+		_, err := a.CreateSlot(ctx, "slot2", "", nil, false)
+		require.NoError(t, err, "should be without errors")
+		require.NoError(t, a.AddBannerRotation(ctx, "banner1", "slot2", false), "should be without errors")
+
+		err = a.SetFrequencyCap(ctx, "banner1", "slot2", sqlstorage.FrequencyCap{PerHour: 2})
+		require.NoError(t, err, "should be without errors")
+
+		banner, _, err := a.GetBanner(ctx, "slot2", "social_demo1", "user1")
+		require.NoError(t, err, "views in other slots should not count to the cap")
+		require.Equal(t, "banner1", banner.ID)
+	})
+
+	t.Run("test capped page", func(t *testing.T) {
+		page, err := a.GetBannersForPage(ctx, []string{"slot1", "slot1", "slot1"}, "social_demo1", "user3", false)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, "banner1", page[1].BannerID)
+		require.Empty(t, page[2].BannerID, "banners of the page should be capped")
+	})
+}
@@ -0,0 +1,238 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	simpleproducer "github.com/VladimirButakov/otus-project/internal/amqp/producer"
+	"github.com/VladimirButakov/otus-project/internal/metrics"
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+)
+
+// PageBanner is the banner selected for a slot of a page. BannerID is empty
+// when the slot has no banner to show. Clicks refer to the banner by ImpressionID.
+type PageBanner struct {
+	SlotID       string
+	BannerID     string
+	ImpressionID string
+	Creative     sqlstorage.Creative
+	path         string
+}
+
+// GetBannersForPage selects a banner for every slot of a page and records their
+// views in a single transaction. Rotations and event counts of all slots are read
+// at once. With unique, a banner is shown in one slot of the page at most.
+// Frequency caps of the user count the banners selected for the page too.
+func (a *App) GetBannersForPage(
+	ctx context.Context,
+	slotIDs []string,
+	socialDemoID string,
+	userID string,
+	unique bool,
+) ([]PageBanner, error) {
+	var page []PageBanner
+
+	date := time.Now().String()
+
+	err := a.storage.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		page, err = a.selectPageBanners(ctx, slotIDs, socialDemoID, userID, unique)
+		if err != nil {
+			return err
+		}
+
+		if err := a.setCreatives(ctx, page); err != nil {
+			return err
+		}
+
+		for i, banner := range page {
+			if banner.BannerID == "" {
+				continue
+			}
+
+			page[i].ImpressionID, err = a.addView(ctx, banner.BannerID, banner.SlotID, socialDemoID, userID, date)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, banner := range page {
+		if banner.BannerID == "" {
+			continue
+		}
+
+		metrics.Selections.WithLabelValues(banner.SlotID, banner.path).Inc()
+
+		err = a.producer.Publish(ctx, simpleproducer.AMQPMessage{Type: "view", SlotID: banner.SlotID, BannerID: banner.BannerID, SocialDemoID: socialDemoID, Date: date})
+		if err != nil {
+			return nil, fmt.Errorf("cannot publish banner view, %w", err)
+		}
+	}
+
+	return page, nil
+}
+
+// setCreatives sets the creatives of the selected banners with a single query.
+func (a *App) setCreatives(ctx context.Context, page []PageBanner) error {
+	ids := make([]string, 0, len(page))
+
+	for _, banner := range page {
+		if banner.BannerID != "" {
+			ids = append(ids, banner.BannerID)
+		}
+	}
+
+	banners, err := a.storage.GetBanners(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	creatives := make(map[string]sqlstorage.Creative, len(banners))
+	for _, banner := range banners {
+		creatives[banner.ID] = banner.Creative
+	}
+
+	for i := range page {
+		page[i].Creative = creatives[page[i].BannerID]
+	}
+
+	return nil
+}
+
+func (a *App) selectPageBanners(
+	ctx context.Context,
+	slotIDs []string,
+	socialDemoID string,
+	userID string,
+	unique bool,
+) ([]PageBanner, error) {
+	bannersInSlots, err := a.storage.GetBannersInSlots(ctx, slotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := a.getTargeting(ctx, slotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	userViews, err := a.getUserViews(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	notViewedBanners, err := a.storage.GetNotViewedBannersInSlots(ctx, slotIDs, socialDemoID)
+	if err != nil {
+		return nil, err
+	}
+
+	counters, err := a.storage.GetEventCountsInSlots(ctx, slotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string][]sqlstorage.BannerRotationItem)
+	for _, item := range bannersInSlots {
+		entries[item.SlotID] = append(entries[item.SlotID], item)
+	}
+
+	notViewed := make(map[string][]string)
+	for _, item := range notViewedBanners {
+		notViewed[item.SlotID] = append(notViewed[item.SlotID], item.BannerID)
+	}
+
+	clicks := make(map[string]map[string]int)
+	views := make(map[string]map[string]int)
+
+	for _, counter := range counters {
+		if clicks[counter.SlotID] == nil {
+			clicks[counter.SlotID] = make(map[string]int)
+			views[counter.SlotID] = make(map[string]int)
+		}
+
+		if counter.Clicks > 0 {
+			clicks[counter.SlotID][counter.BannerID] = counter.Clicks
+		}
+
+		if counter.Views > 0 {
+			views[counter.SlotID][counter.BannerID] = counter.Views
+		}
+	}
+
+	page := make([]PageBanner, 0, len(slotIDs))
+	shown := make(map[string]bool)
+
+	for _, slotID := range slotIDs {
+		slotEntries, allowed := userViews.uncapped(rules.eligible(entries[slotID], socialDemoID))
+
+		banners := make([]string, 0, len(slotEntries))
+		for _, item := range slotEntries {
+			banners = append(banners, item.BannerID)
+		}
+
+		slotNotViewed := make([]string, 0, len(notViewed[slotID]))
+		for _, bannerID := range notViewed[slotID] {
+			if allowed[bannerID] {
+				slotNotViewed = append(slotNotViewed, bannerID)
+			}
+		}
+
+		bannerID, path := a.selectPageBanner(banners, slotNotViewed, clicks[slotID], views[slotID], shown, unique)
+
+		if bannerID != "" {
+			userViews.add(slotID, bannerID)
+
+			if unique {
+				shown[bannerID] = true
+			}
+		}
+
+		page = append(page, PageBanner{SlotID: slotID, BannerID: bannerID, path: path})
+	}
+
+	return page, nil
+}
+
+// selectPageBanner returns a banner the social demo group has not seen yet, if any,
+// otherwise the one chosen by the bandit, skipping banners already on the page,
+// with the selection path taken.
+func (a *App) selectPageBanner(
+	banners []string,
+	notViewed []string,
+	clicks map[string]int,
+	views map[string]int,
+	shown map[string]bool,
+	unique bool,
+) (string, string) {
+	for _, bannerID := range notViewed {
+		if !shown[bannerID] {
+			return bannerID, metrics.PathUnseen
+		}
+	}
+
+	candidates := banners
+	if unique {
+		candidates = make([]string, 0, len(banners))
+
+		for _, bannerID := range banners {
+			if !shown[bannerID] {
+				candidates = append(candidates, bannerID)
+			}
+		}
+	}
+
+	bannerID, err := a.bandit.Use(candidates, clicks, views)
+	if err != nil {
+		return "", ""
+	}
+
+	return bannerID, metrics.PathUCB
+}
@@ -0,0 +1,125 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VladimirButakov/otus-project/internal/metrics"
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBannersForPage(t *testing.T) {
+	ctx := context.Background()
+	a, storage, p := newTestApp(t)
+
+	for _, id := range []string{"slot1", "slot2", "slot3"} {
+		_, err := storage.CreateSlot(ctx, id, "", nil)
+		require.NoError(t, err, "should be without errors")
+	}
+
+	for _, id := range []string{"banner1", "banner2"} {
+		_, err := storage.CreateBanner(ctx, id, "", sqlstorage.Creative{})
+		require.NoError(t, err, "should be without errors")
+
+		for _, slotID := range []string{"slot1", "slot2"} {
+			err := storage.AddBannerRotation(ctx, id, slotID)
+			require.NoError(t, err, "should be without errors")
+		}
+	}
+
+	t.Run("test unique banners on page", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			page, err := a.GetBannersForPage(ctx, []string{"slot1", "slot2"}, "social_demo1", "", true)
+			require.NoError(t, err, "should be without errors")
+			require.Len(t, page, 2)
+			require.NotEmpty(t, page[0].BannerID, "banner should be selected")
+			require.NotEmpty(t, page[1].BannerID, "banner should be selected")
+			require.NotEqual(t, page[0].BannerID, page[1].BannerID, "banners should be unique on page")
+		}
+
+		counts, err := storage.GetEventCountsInSlots(ctx, []string{"slot1"})
+		require.NoError(t, err, "should be without errors")
+
+		views := 0
+		for _, count := range counts {
+			views += count.Views
+		}
+
+		require.Equal(t, 5, views, "views should be recorded")
+		require.Len(t, p.messages, 10, "views should be published")
+	})
+
+	t.Run("test slot without banners", func(t *testing.T) {
+		page, err := a.GetBannersForPage(ctx, []string{"slot1", "slot3"}, "social_demo1", "", false)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, "slot3", page[1].SlotID, "slots should keep request order")
+		require.Empty(t, page[1].BannerID, "slot without banners should be empty")
+		require.NotEmpty(t, page[0].BannerID, "banner should be selected")
+	})
+
+	t.Run("test unique page with more slots than banners", func(t *testing.T) {
+		page, err := a.GetBannersForPage(ctx, []string{"slot1", "slot2", "slot1"}, "social_demo2", "", true)
+		require.NoError(t, err, "should be without errors")
+		require.Empty(t, page[2].BannerID, "banners should not be repeated")
+	})
+
+	t.Run("test click on impression", func(t *testing.T) {
+		page, err := a.GetBannersForPage(ctx, []string{"slot1"}, "social_demo3", "", false)
+		require.NoError(t, err, "should be without errors")
+		require.NotEmpty(t, page[0].ImpressionID, "impression should be returned")
+
+		impressionID := page[0].ImpressionID
+
+		err = a.AddClickEvent(ctx, "other_banner", "", "", "", impressionID)
+		require.ErrorIs(t, err, ErrImpressionMismatch, "click on other banner should fail")
+
+		err = a.AddClickEvent(ctx, "", "", "", "", impressionID)
+		require.NoError(t, err, "should be without errors")
+
+		stats, err := storage.GetStats(ctx, sqlstorage.StatsFilter{SlotID: "slot1", SocialDemoID: "social_demo3"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, stats, 1)
+		require.Equal(t, page[0].BannerID, stats[0].BannerID, "click should be taken from impression")
+		require.Equal(t, 1, stats[0].Clicks, "click should be recorded once for the social demo of the impression")
+
+		err = a.AddClickEvent(ctx, "", "", "", "", impressionID)
+		require.ErrorIs(t, err, sqlstorage.ErrAlreadyClicked, "impression should be clicked once")
+
+		err = a.AddClickEvent(ctx, "", "", "", "", "unknown")
+		require.ErrorIs(t, err, sqlstorage.ErrNotFound, "unknown impression should not be clicked")
+	})
+
+	t.Run("test click without impression", func(t *testing.T) {
+		series := testutil.CollectAndCount(metrics.Clicks)
+
+		err := a.AddClickEvent(ctx, "banner1", "slot3", "social_demo3", "", "")
+		require.ErrorIs(t, err, ErrNotInRotation, "banner out of rotation should not be clicked")
+
+		err = a.AddClickEvent(ctx, "banner1", "unknown", "social_demo3", "", "")
+		require.ErrorIs(t, err, ErrNotInRotation, "unknown slot should not be clicked")
+		require.Equal(t, series, testutil.CollectAndCount(metrics.Clicks), "rejected clicks should not add series")
+
+		clicks := testutil.ToFloat64(metrics.Clicks.WithLabelValues("slot2"))
+
+		err = a.AddClickEvent(ctx, "banner1", "slot2", "social_demo3", "", "")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, clicks+1, testutil.ToFloat64(metrics.Clicks.WithLabelValues("slot2")), "valid click should be counted")
+	})
+
+	t.Run("test selection metrics", func(t *testing.T) {
+		unseen := testutil.ToFloat64(metrics.Selections.WithLabelValues("slot2", metrics.PathUnseen))
+		ucb := testutil.ToFloat64(metrics.Selections.WithLabelValues("slot2", metrics.PathUCB))
+
+		for i := 0; i < 3; i++ {
+			_, err := a.GetBannersForPage(ctx, []string{"slot2"}, "social_demo4", "", false)
+			require.NoError(t, err, "should be without errors")
+		}
+
+		require.Equal(t, unseen+2, testutil.ToFloat64(metrics.Selections.WithLabelValues("slot2", metrics.PathUnseen)),
+			"unseen banners should be selected first")
+		require.Equal(t, ucb+1, testutil.ToFloat64(metrics.Selections.WithLabelValues("slot2", metrics.PathUCB)),
+			"bandit should select when every banner was seen")
+	})
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"math"
+	"time"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+)
+
+// confidenceZ is the normal quantile of the 95% confidence interval.
+const confidenceZ = 1.96
+
+// BannerStats is the performance of a banner, in a period when stats are grouped.
+type BannerStats struct {
+	BannerID string
+	Period   time.Time
+	Views    int
+	Clicks   int
+	CTR      float64
+	CTRLower float64
+	CTRUpper float64
+}
+
+func (a *App) GetStats(ctx context.Context, filter sqlstorage.StatsFilter) ([]BannerStats, error) {
+	items, err := a.storage.GetStats(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]BannerStats, 0, len(items))
+
+	for _, item := range items {
+		s := BannerStats{BannerID: item.BannerID, Period: item.Period, Views: item.Views, Clicks: item.Clicks}
+
+		if item.Views > 0 {
+			s.CTR = float64(item.Clicks) / float64(item.Views)
+			s.CTRLower, s.CTRUpper = wilsonInterval(item.Clicks, item.Views)
+		}
+
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
+// wilsonInterval returns the Wilson score interval of the click-through rate,
+// which stays within [0, 1] and is usable for banners with few views.
+func wilsonInterval(clicks int, views int) (lower float64, upper float64) {
+	n := float64(views)
+	p := math.Min(float64(clicks)/n, 1)
+	z2 := confidenceZ * confidenceZ
+
+	center := (p + z2/(2*n)) / (1 + z2/n)
+	margin := confidenceZ / (1 + z2/n) * math.Sqrt(p*(1-p)/n+z2/(4*n*n))
+
+	return math.Max(center-margin, 0), math.Min(center+margin, 1)
+}
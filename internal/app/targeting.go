@@ -0,0 +1,79 @@
+package app
+
+import (
+	"context"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+)
+
+type targetRule struct {
+	include map[string]bool
+	exclude map[string]bool
+}
+
+// targetingRules holds the targeting of banners in rotation. Banners without
+// rules are shown to every social demo group.
+type targetingRules map[rotationKey]*targetRule
+
+func (a *App) getTargeting(ctx context.Context, slotIDs []string) (targetingRules, error) {
+	items, err := a.storage.GetTargetingInSlots(ctx, slotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(targetingRules)
+
+	for _, item := range items {
+		key := rotationKey{item.SlotID, item.BannerID}
+
+		rule := rules[key]
+		if rule == nil {
+			rule = &targetRule{include: make(map[string]bool), exclude: make(map[string]bool)}
+			rules[key] = rule
+		}
+
+		if item.Excluded {
+			rule.exclude[item.SocialDemoID] = true
+		} else {
+			rule.include[item.SocialDemoID] = true
+		}
+	}
+
+	return rules, nil
+}
+
+// allows reports whether the banner in rotation may be shown to the social demo group.
+func (r targetingRules) allows(item sqlstorage.BannerRotationItem, socialDemoID string) bool {
+	rule := r[rotationKey{item.SlotID, item.BannerID}]
+	if rule == nil {
+		return true
+	}
+
+	if rule.exclude[socialDemoID] {
+		return false
+	}
+
+	return len(rule.include) == 0 || rule.include[socialDemoID]
+}
+
+// eligible returns the rotation entries which may be shown to the social demo group.
+func (r targetingRules) eligible(items []sqlstorage.BannerRotationItem, socialDemoID string) []sqlstorage.BannerRotationItem {
+	eligible := make([]sqlstorage.BannerRotationItem, 0, len(items))
+
+	for _, item := range items {
+		if r.allows(item, socialDemoID) {
+			eligible = append(eligible, item)
+		}
+	}
+
+	return eligible
+}
+
+// SetTargeting replaces the social demo groups the banner in the slot is restricted to.
+func (a *App) SetTargeting(ctx context.Context, bannerID string, slotID string, targeting sqlstorage.Targeting) error {
+	return a.storage.SetTargeting(ctx, bannerID, slotID, targeting)
+}
+
+func (a *App) GetTargeting(ctx context.Context, bannerID string, slotID string) (sqlstorage.Targeting, error) {
+	return a.storage.GetTargeting(ctx, bannerID, slotID)
+}
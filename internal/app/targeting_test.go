@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargeting(t *testing.T) {
+	ctx := context.Background()
+	a, _, _ := newTestApp(t)
+
+	_, err := a.CreateSlot(ctx, "slot1", "", nil, false)
+	require.NoError(t, err, "should be without errors")
+
+	for _, id := range []string{"kids", "adults", "teens"} {
+		_, err = a.CreateSocialDemo(ctx, id, "", false)
+		require.NoError(t, err, "should be without errors")
+	}
+
+	for _, id := range []string{"toys", "wine"} {
+		_, err = a.CreateBanner(ctx, id, "", sqlstorage.Creative{}, false)
+		require.NoError(t, err, "should be without errors")
+		require.NoError(t, a.AddBannerRotation(ctx, id, "slot1", false), "should be without errors")
+	}
+
+	err = a.SetTargeting(ctx, "toys", "slot1", sqlstorage.Targeting{Include: []string{"kids"}})
+	require.NoError(t, err, "should be without errors")
+
+	err = a.SetTargeting(ctx, "wine", "slot1", sqlstorage.Targeting{Exclude: []string{"kids", "teens"}})
+	require.NoError(t, err, "should be without errors")
+
+	t.Run("test targeted banners", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			banner, _, err := a.GetBanner(ctx, "slot1", "kids", "")
+			require.NoError(t, err, "should be without errors")
+			require.Equal(t, "toys", banner.ID, "excluded banner should not be shown")
+
+			banner, _, err = a.GetBanner(ctx, "slot1", "adults", "")
+			require.NoError(t, err, "should be without errors")
+			require.Equal(t, "wine", banner.ID, "banner should be shown to included groups only")
+		}
+
+		_, _, err := a.GetBanner(ctx, "slot1", "teens", "")
+		require.ErrorIs(t, err, ErrNoEligibleBanner)
+
+		page, err := a.GetBannersForPage(ctx, []string{"slot1"}, "teens", "", false)
+		require.NoError(t, err, "should be without errors")
+		require.Empty(t, page[0].BannerID, "slot without eligible banners should be empty")
+	})
+
+	t.Run("test targeting errors", func(t *testing.T) {
+		err := a.SetTargeting(ctx, "toys", "slot1", sqlstorage.Targeting{Include: []string{"kids"}, Exclude: []string{"kids"}})
+		require.ErrorIs(t, err, sqlstorage.ErrTargetingConflict)
+
+		err = a.SetTargeting(ctx, "toys", "slot1", sqlstorage.Targeting{Include: []string{"unknown"}})
+		require.ErrorIs(t, err, sqlstorage.ErrForeignKeyViolation)
+
+		err = a.SetTargeting(ctx, "toys", "unknown", sqlstorage.Targeting{})
+		require.ErrorIs(t, err, sqlstorage.ErrNotFound)
+
+		targeting, err := a.GetTargeting(ctx, "toys", "slot1")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, sqlstorage.Targeting{Include: []string{"kids"}}, targeting, "failed updates should be rolled back")
+	})
+
+	t.Run("test targeting removed with rotation", func(t *testing.T) {
+		require.NoError(t, a.RemoveBannerRotation(ctx, "wine", "slot1"), "should be without errors")
+		require.NoError(t, a.AddBannerRotation(ctx, "wine", "slot1", false), "should be without errors")
+
+		targeting, err := a.GetTargeting(ctx, "wine", "slot1")
+		require.NoError(t, err, "should be without errors")
+		require.Empty(t, targeting.Exclude, "targeting should be removed with the rotation entry")
+	})
+
+	t.Run("test delete targeted social demo", func(t *testing.T) {
+		_, err := a.CreateBanner(ctx, "games", "", sqlstorage.Creative{}, false)
+		require.NoError(t, err, "should be without errors")
+		require.NoError(t, a.AddBannerRotation(ctx, "games", "slot1", false), "should be without errors")
+
+		err = a.SetTargeting(ctx, "games", "slot1", sqlstorage.Targeting{Include: []string{"kids", "teens"}})
+		require.NoError(t, err, "should be without errors")
+
+		err = a.SetTargeting(ctx, "wine", "slot1", sqlstorage.Targeting{Exclude: []string{"kids"}})
+		require.NoError(t, err, "should be without errors")
+
+		err = a.DeleteSocialDemo(ctx, "kids", false)
+		require.ErrorIs(t, err, sqlstorage.ErrInRotation, "targeted group should not be deleted without force")
+
+		targeting, err := a.GetTargeting(ctx, "toys", "slot1")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, []string{"kids"}, targeting.Include, "targeting should be kept")
+
+		err = a.DeleteSocialDemo(ctx, "kids", true)
+		require.NoError(t, err, "should be without errors")
+
+		_, err = a.GetTargeting(ctx, "toys", "slot1")
+		require.ErrorIs(t, err, sqlstorage.ErrNotFound, "banner targeted at the deleted group only should be removed from rotation")
+
+		targeting, err = a.GetTargeting(ctx, "wine", "slot1")
+		require.NoError(t, err, "should be without errors")
+		require.Empty(t, targeting.Exclude, "exclude rule of the deleted group should be dropped")
+
+		targeting, err = a.GetTargeting(ctx, "games", "slot1")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, []string{"teens"}, targeting.Include, "other include targets should be kept")
+
+		for i := 0; i < 3; i++ {
+			banner, _, err := a.GetBanner(ctx, "slot1", "adults", "")
+			require.NoError(t, err, "should be without errors")
+			require.Equal(t, "wine", banner.ID, "banner which excluded the deleted group should stay in rotation")
+		}
+
+		require.NoError(t, a.DeleteSocialDemo(ctx, "adults", false), "should be without errors")
+	})
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/VladimirButakov/otus-project/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Role is a set of methods a caller may call. The admin role grants every role.
+type Role string
+
+const (
+	RolePublic Role = "public"
+	RoleAdmin  Role = "admin"
+)
+
+// Principal is an authenticated caller.
+type Principal struct {
+	Subject string
+	Role    Role
+}
+
+// Has reports whether the principal is granted the role.
+func (p Principal) Has(role Role) bool {
+	return p.Role == RoleAdmin || p.Role == role
+}
+
+var (
+	ErrUnauthenticated  = errors.New("missing or invalid credentials")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrUnknownRole      = errors.New("unknown role")
+	ErrEmptyAPIKey      = errors.New("api key is empty")
+	ErrEmptyJWTKey      = errors.New("jwt key is empty")
+	ErrNoCredentials    = errors.New("no api keys or jwt keys are configured")
+	ErrUnknownKey       = errors.New("unknown key id")
+)
+
+type Authenticator struct {
+	apiKeys   map[[sha256.Size]byte]Principal
+	jwtKeys   map[string][]byte
+	parser    *jwt.Parser
+	anonymous Role
+}
+
+type claims struct {
+	Role Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// New returns an authenticator of the configured API keys and JWT keys. At least
+// one key is required, so enabled authentication cannot be left without callers.
+func New(conf config.AuthConf) (*Authenticator, error) {
+	if len(conf.APIKeys) == 0 && len(conf.JWT.Keys) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	a := &Authenticator{
+		apiKeys:   make(map[[sha256.Size]byte]Principal, len(conf.APIKeys)),
+		jwtKeys:   make(map[string][]byte, len(conf.JWT.Keys)),
+		anonymous: Role(conf.AnonymousRole),
+	}
+
+	if a.anonymous != "" && !validRole(a.anonymous) {
+		return nil, fmt.Errorf("cannot use anonymous role %s, %w", a.anonymous, ErrUnknownRole)
+	}
+
+	for _, key := range conf.APIKeys {
+		if !validRole(Role(key.Role)) {
+			return nil, fmt.Errorf("cannot use role %s of api key %s, %w", key.Role, key.Name, ErrUnknownRole)
+		}
+
+		if key.Key == "" {
+			return nil, fmt.Errorf("cannot use api key %s, %w", key.Name, ErrEmptyAPIKey)
+		}
+
+		// Keys are looked up by hash, so lookups do not leak them through timing.
+		a.apiKeys[sha256.Sum256([]byte(key.Key))] = Principal{Subject: key.Name, Role: Role(key.Role)}
+	}
+
+	for id, key := range conf.JWT.Keys {
+		if key == "" {
+			return nil, fmt.Errorf("cannot use jwt key %s, %w", id, ErrEmptyJWTKey)
+		}
+
+		a.jwtKeys[strings.ToLower(id)] = []byte(key)
+	}
+
+	options := []jwt.ParserOption{jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}), jwt.WithExpirationRequired()}
+
+	if conf.JWT.Issuer != "" {
+		options = append(options, jwt.WithIssuer(conf.JWT.Issuer))
+	}
+
+	if conf.JWT.Audience != "" {
+		options = append(options, jwt.WithAudience(conf.JWT.Audience))
+	}
+
+	a.parser = jwt.NewParser(options...)
+
+	return a, nil
+}
+
+// Authenticate returns the caller of the API key or the bearer token. Without
+// credentials the caller is anonymous, if anonymous callers are allowed.
+func (a *Authenticator) Authenticate(apiKey string, token string) (Principal, error) {
+	switch {
+	case apiKey != "":
+		principal, ok := a.apiKeys[sha256.Sum256([]byte(apiKey))]
+		if !ok {
+			return Principal{}, fmt.Errorf("cannot authenticate api key, %w", ErrUnauthenticated)
+		}
+
+		return principal, nil
+	case token != "":
+		return a.verify(token)
+	case a.anonymous != "":
+		return Principal{Role: a.anonymous}, nil
+	default:
+		return Principal{}, ErrUnauthenticated
+	}
+}
+
+func (a *Authenticator) verify(token string) (Principal, error) {
+	var tokenClaims claims
+
+	_, err := a.parser.ParseWithClaims(token, &tokenClaims, a.key)
+	if err != nil {
+		return Principal{}, fmt.Errorf("cannot verify token, %w: %s", ErrUnauthenticated, err)
+	}
+
+	if !validRole(tokenClaims.Role) {
+		return Principal{}, fmt.Errorf("cannot use role %s of token, %w", tokenClaims.Role, ErrUnauthenticated)
+	}
+
+	return Principal{Subject: tokenClaims.Subject, Role: tokenClaims.Role}, nil
+}
+
+func (a *Authenticator) key(token *jwt.Token) (interface{}, error) {
+	id, _ := token.Header["kid"].(string)
+
+	if id == "" && len(a.jwtKeys) == 1 {
+		for _, key := range a.jwtKeys {
+			return key, nil
+		}
+	}
+
+	key, ok := a.jwtKeys[strings.ToLower(id)]
+	if !ok {
+		return nil, fmt.Errorf("cannot use key %q, %w", id, ErrUnknownKey)
+	}
+
+	return key, nil
+}
+
+func validRole(role Role) bool {
+	return role == RolePublic || role == RoleAdmin
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the authenticated caller.
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the authenticated caller of the context, if any.
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+
+	return principal, ok
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticate(t *testing.T) {
+	conf := config.AuthConf{
+		Enabled: true,
+		APIKeys: []config.APIKeyConf{
+			{Name: "dashboard", Key: "admin-key", Role: "admin"},
+			{Name: "site", Key: "public-key", Role: "public"},
+		},
+		JWT: config.JWTConf{Keys: map[string]string{"k1": "secret1", "k2": "secret2"}, Issuer: "issuer"},
+	}
+
+	a, err := New(conf)
+	require.NoError(t, err, "should be without errors")
+
+	sign := func(kid string, secret string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		token.Header["kid"] = kid
+
+		signed, err := token.SignedString([]byte(secret))
+		require.NoError(t, err, "should be without errors")
+
+		return signed
+	}
+
+	t.Run("test api keys", func(t *testing.T) {
+		principal, err := a.Authenticate("admin-key", "")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, Principal{Subject: "dashboard", Role: RoleAdmin}, principal)
+		require.True(t, principal.Has(RolePublic), "admin should have every role")
+
+		principal, err = a.Authenticate("public-key", "")
+		require.NoError(t, err, "should be without errors")
+		require.False(t, principal.Has(RoleAdmin), "public caller should not be admin")
+
+		_, err = a.Authenticate("unknown", "")
+		require.ErrorIs(t, err, ErrUnauthenticated)
+
+		_, err = a.Authenticate("", "")
+		require.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("test tokens", func(t *testing.T) {
+		exp := time.Now().Add(time.Hour).Unix()
+
+		principal, err := a.Authenticate("", sign("K1", "secret1", jwt.MapClaims{"sub": "user", "role": "admin", "iss": "issuer", "exp": exp}))
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, Principal{Subject: "user", Role: RoleAdmin}, principal)
+
+		for _, token := range []string{
+			sign("k1", "secret2", jwt.MapClaims{"role": "admin", "iss": "issuer", "exp": exp}),
+			sign("k3", "secret1", jwt.MapClaims{"role": "admin", "iss": "issuer", "exp": exp}),
+			sign("k1", "secret1", jwt.MapClaims{"role": "admin", "iss": "other", "exp": exp}),
+			sign("k1", "secret1", jwt.MapClaims{"role": "admin", "iss": "issuer"}),
+			sign("k1", "secret1", jwt.MapClaims{"role": "admin", "iss": "issuer", "exp": time.Now().Add(-time.Minute).Unix()}),
+			sign("k1", "secret1", jwt.MapClaims{"role": "root", "iss": "issuer", "exp": exp}),
+			"not a token",
+		} {
+			_, err := a.Authenticate("", token)
+			require.ErrorIs(t, err, ErrUnauthenticated)
+		}
+	})
+
+	t.Run("test anonymous role", func(t *testing.T) {
+		conf.AnonymousRole = "public"
+
+		a, err := New(conf)
+		require.NoError(t, err, "should be without errors")
+
+		principal, err := a.Authenticate("", "")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, RolePublic, principal.Role, "anonymous caller should get the anonymous role")
+
+		conf.AnonymousRole = "root"
+
+		_, err = New(conf)
+		require.ErrorIs(t, err, ErrUnknownRole)
+	})
+
+	t.Run("test missing keys", func(t *testing.T) {
+		_, err := New(config.AuthConf{Enabled: true, AnonymousRole: "public"})
+		require.ErrorIs(t, err, ErrNoCredentials, "auth without keys should fail")
+
+		_, err = New(config.AuthConf{Enabled: true, APIKeys: []config.APIKeyConf{{Name: "admin", Role: "admin"}}})
+		require.ErrorIs(t, err, ErrEmptyAPIKey, "key from an unset variable should fail")
+
+		_, err = New(config.AuthConf{Enabled: true, JWT: config.JWTConf{Keys: map[string]string{"k1": ""}}})
+		require.ErrorIs(t, err, ErrEmptyJWTKey)
+	})
+}
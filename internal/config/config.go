@@ -2,15 +2,22 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Logger LoggerConf `json:"logger"`
-	DB     DBConf     `json:"db"`
-	HTTP   HTTPConf   `json:"http"`
-	AMPQ   AMPQConf   `json:"ampq"`
+	Logger    LoggerConf    `json:"logger"`
+	DB        DBConf        `json:"db"`
+	HTTP      HTTPConf      `json:"http"`
+	AMPQ      AMPQConf      `json:"ampq"`
+	Retention RetentionConf `json:"retention"`
+	Events    EventsConf    `json:"events"`
+	Auth      AuthConf      `json:"auth"`
+	RateLimit RateLimitConf `json:"rate_limit"`
+	Tracing   TracingConf   `json:"tracing"`
 }
 
 type LoggerConf struct {
@@ -19,7 +26,11 @@ type LoggerConf struct {
 }
 
 type DBConf struct {
-	ConnectionString string `json:"connection_string"`
+	Driver               string        `json:"driver"`
+	ConnectionString     string        `json:"connection_string"`
+	QueryTimeout         time.Duration `json:"query_timeout"`
+	Replicas             []string      `json:"replicas"`
+	ReplicaCheckInterval time.Duration `json:"replica_check_interval"`
 }
 
 type HTTPConf struct {
@@ -33,17 +44,165 @@ type AMPQConf struct {
 	Name string `json:"name"`
 }
 
+// RetentionConf configures the event purge job. A zero Period keeps events forever.
+type RetentionConf struct {
+	Period          time.Duration `json:"period"`
+	Interval        time.Duration `json:"interval"`
+	Archive         bool          `json:"archive"`
+	PartitionsAhead int           `json:"partitions_ahead"`
+}
+
+// EventsConf configures asynchronous batched writes of view and click events,
+// which are off by default. Events of transactions are always written synchronously.
+// ClicksWithoutImpression accepts clicks of old clients, which send the banner,
+// slot and social demo ids instead of the impression id.
+type EventsConf struct {
+	Async                   bool          `json:"async"`
+	QueueSize               int           `json:"queue_size"`
+	BatchSize               int           `json:"batch_size"`
+	FlushInterval           time.Duration `json:"flush_interval"`
+	ClicksWithoutImpression bool          `json:"clicks_without_impression"`
+}
+
+// AuthConf configures authentication of callers. When enabled, requests need an API
+// key or a JWT signed with one of the JWT keys, except requests without credentials
+// when AnonymousRole is set, which get that role.
+type AuthConf struct {
+	Enabled       bool         `json:"enabled"`
+	AnonymousRole string       `json:"anonymous_role"`
+	APIKeys       []APIKeyConf `json:"api_keys"`
+	JWT           JWTConf      `json:"jwt"`
+}
+
+// APIKeyConf is an API key of a caller. KeyEnv names the environment variable the key
+// is read from, so keys need not be stored in config files.
+type APIKeyConf struct {
+	Name   string `json:"name"`
+	Key    string `json:"key"`
+	KeyEnv string `json:"key_env" mapstructure:"key_env"`
+	Role   string `json:"role"`
+}
+
+// JWTConf configures verification of HMAC signed tokens. Keys are secrets by key id,
+// which tokens name in the kid header; a token without kid is verified with the
+// only key. Key ids are case-insensitive. Issuer and Audience are checked when set.
+type JWTConf struct {
+	Keys     map[string]string `json:"keys"`
+	Issuer   string            `json:"issuer"`
+	Audience string            `json:"audience"`
+}
+
+// RateLimitConf configures token bucket limits of requests of each client, which is
+// identified by its authenticated principal, the name of its API key or the subject of
+// its token, or by its IP when anonymous. Methods override the default limit by method
+// name, such as ClickEvent. Buckets of clients idle for ClientTTL are dropped.
+type RateLimitConf struct {
+	Enabled   bool                 `json:"enabled"`
+	Default   LimitConf            `json:"default"`
+	Methods   map[string]LimitConf `json:"methods"`
+	ClientTTL time.Duration        `json:"client_ttl"`
+}
+
+// LimitConf is a limit of Rate requests per second with bursts of up to Burst requests.
+// A zero Rate is no limit.
+type LimitConf struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// TracingConf configures OpenTelemetry tracing. Exporter is none, stdout or otlp,
+// which sends spans over grpc to Endpoint. SampleRatio is the share of traces sampled
+// when the caller has not decided it.
+type TracingConf struct {
+	Exporter    string  `json:"exporter"`
+	Endpoint    string  `json:"endpoint"`
+	Insecure    bool    `json:"insecure"`
+	SampleRatio float64 `json:"sample_ratio"`
+}
+
 func New(configFile string) (Config, error) {
 	viper.SetConfigFile(configFile)
+	viper.SetDefault("db.driver", "postgres")
+	viper.SetDefault("db.query_timeout", "5s")
+	viper.SetDefault("db.replica_check_interval", "10s")
+	viper.SetDefault("retention.interval", "1h")
+	viper.SetDefault("retention.partitions_ahead", 3)
+	viper.SetDefault("events.queue_size", 10000)
+	viper.SetDefault("events.batch_size", 500)
+	viper.SetDefault("events.flush_interval", "1s")
+	viper.SetDefault("rate_limit.client_ttl", "10m")
+	viper.SetDefault("tracing.exporter", "none")
+	viper.SetDefault("tracing.endpoint", "localhost:4317")
+	viper.SetDefault("tracing.sample_ratio", 1)
 
 	if err := viper.ReadInConfig(); err != nil { // Handle errors reading the config file
 		return Config{}, fmt.Errorf("fatal error config file: %w", err)
 	}
 
+	var apiKeys []APIKeyConf
+	if err := viper.UnmarshalKey("auth.api_keys", &apiKeys); err != nil {
+		return Config{}, fmt.Errorf("cannot read api keys, %w", err)
+	}
+
+	for i, key := range apiKeys {
+		if key.KeyEnv != "" {
+			apiKeys[i].Key = os.Getenv(key.KeyEnv)
+		}
+	}
+
+	var methodLimits map[string]LimitConf
+	if err := viper.UnmarshalKey("rate_limit.methods", &methodLimits); err != nil {
+		return Config{}, fmt.Errorf("cannot read method rate limits, %w", err)
+	}
+
 	return Config{
 		LoggerConf{Level: viper.GetString("logger.level"), File: viper.GetString("logger.file")},
-		DBConf{ConnectionString: viper.GetString("db.connection_string")},
+		DBConf{
+			Driver:               viper.GetString("db.driver"),
+			ConnectionString:     viper.GetString("db.connection_string"),
+			QueryTimeout:         viper.GetDuration("db.query_timeout"),
+			Replicas:             viper.GetStringSlice("db.replicas"),
+			ReplicaCheckInterval: viper.GetDuration("db.replica_check_interval"),
+		},
 		HTTPConf{Host: viper.GetString("http.host"), Port: viper.GetString("http.port"), GrpcPort: viper.GetString("http.grpc_port")},
 		AMPQConf{URI: viper.GetString("ampq.uri"), Name: viper.GetString("ampq.name")},
+		RetentionConf{
+			Period:          viper.GetDuration("retention.period"),
+			Interval:        viper.GetDuration("retention.interval"),
+			Archive:         viper.GetBool("retention.archive"),
+			PartitionsAhead: viper.GetInt("retention.partitions_ahead"),
+		},
+		EventsConf{
+			Async:                   viper.GetBool("events.async"),
+			QueueSize:               viper.GetInt("events.queue_size"),
+			BatchSize:               viper.GetInt("events.batch_size"),
+			FlushInterval:           viper.GetDuration("events.flush_interval"),
+			ClicksWithoutImpression: viper.GetBool("events.clicks_without_impression"),
+		},
+		AuthConf{
+			Enabled:       viper.GetBool("auth.enabled"),
+			AnonymousRole: viper.GetString("auth.anonymous_role"),
+			APIKeys:       apiKeys,
+			JWT: JWTConf{
+				Keys:     viper.GetStringMapString("auth.jwt.keys"),
+				Issuer:   viper.GetString("auth.jwt.issuer"),
+				Audience: viper.GetString("auth.jwt.audience"),
+			},
+		},
+		RateLimitConf{
+			Enabled: viper.GetBool("rate_limit.enabled"),
+			Default: LimitConf{
+				Rate:  viper.GetFloat64("rate_limit.default.rate"),
+				Burst: viper.GetInt("rate_limit.default.burst"),
+			},
+			Methods:   methodLimits,
+			ClientTTL: viper.GetDuration("rate_limit.client_ttl"),
+		},
+		TracingConf{
+			Exporter:    viper.GetString("tracing.exporter"),
+			Endpoint:    viper.GetString("tracing.endpoint"),
+			Insecure:    viper.GetBool("tracing.insecure"),
+			SampleRatio: viper.GetFloat64("tracing.sample_ratio"),
+		},
 	}, nil
 }
@@ -0,0 +1,102 @@
+// Package metrics holds the Prometheus collectors of the service, which are
+// registered in the default registry and served by the gateway at /metrics.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "banners_rotation"
+
+// Selection paths, the ways a banner is selected for a slot.
+const (
+	PathUnseen = "unseen"
+	PathUCB    = "ucb"
+)
+
+var (
+	// GRPCRequests counts handled grpc requests by full method name and status code.
+	GRPCRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Handled grpc requests by method and status code.",
+	}, []string{"method", "code"})
+
+	// GRPCDuration observes latencies of grpc requests by full method name and status code.
+	GRPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latencies of grpc requests by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	// Selections counts banners selected for slots by the selection path.
+	Selections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "selections_total",
+		Help:      "Banners selected for slots by slot and selection path, unseen or ucb.",
+	}, []string{"slot_id", "path"})
+
+	// Clicks counts recorded clicks by slot.
+	Clicks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "clicks_total",
+		Help:      "Recorded banner clicks by slot.",
+	}, []string{"slot_id"})
+
+	// DBQueryDuration observes latencies of storage queries by statement and target,
+	// primary or replica.
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Latencies of storage queries by statement and target, primary or replica.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"statement", "target"})
+
+	// EventQueueDepth is the number of events waiting on the queue of the async
+	// event writer, updated on every flush.
+	EventQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "queue_depth",
+		Help:      "Events waiting on the queue of the async event writer.",
+	})
+
+	// EventsDropped counts events lost by the async event writer because the queue
+	// was full or the insert failed.
+	EventsDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "dropped_total",
+		Help:      "Events lost because the queue was full or the insert failed.",
+	})
+
+	// AMQPPublishes counts published event messages by result, ok or error.
+	AMQPPublishes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "amqp",
+		Name:      "publishes_total",
+		Help:      "Published event messages by result, ok or error.",
+	}, []string{"result"})
+)
+
+// Result returns the result label of an operation which returned err.
+func Result(err error) string {
+	if err != nil {
+		return "error"
+	}
+
+	return "ok"
+}
+
+// Handler serves the metrics of the default registry.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,81 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/config"
+)
+
+type Logger interface {
+	Info(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+type Storage interface {
+	CreateEventPartitions(ctx context.Context, from time.Time, days int) error
+	PurgeEvents(ctx context.Context, before time.Time, archive bool) (int, error)
+}
+
+// Job keeps event partitions created ahead of time and purges events older
+// than the retention period.
+type Job struct {
+	logger  Logger
+	storage Storage
+	conf    config.RetentionConf
+	now     func() time.Time
+}
+
+func New(logger Logger, storage Storage, conf config.RetentionConf) *Job {
+	return &Job{logger: logger, storage: storage, conf: conf, now: time.Now}
+}
+
+// Run purges events once and then on every interval until ctx is done.
+func (j *Job) Run(ctx context.Context) {
+	if j.conf.Interval <= 0 {
+		if err := j.Purge(ctx); err != nil {
+			j.logger.Error(err.Error())
+		}
+
+		return
+	}
+
+	ticker := time.NewTicker(j.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Purge(ctx); err != nil {
+			j.logger.Error(err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *Job) Purge(ctx context.Context) error {
+	now := j.now()
+
+	if err := j.storage.CreateEventPartitions(ctx, now, j.conf.PartitionsAhead); err != nil {
+		return fmt.Errorf("cannot create event partitions, %w", err)
+	}
+
+	if j.conf.Period <= 0 {
+		return nil
+	}
+
+	before := now.Add(-j.conf.Period)
+
+	purged, err := j.storage.PurgeEvents(ctx, before, j.conf.Archive)
+	if err != nil {
+		return fmt.Errorf("cannot purge events, %w", err)
+	}
+
+	j.logger.Info("events purged", "before", before, "partitions", purged, "archive", j.conf.Archive)
+
+	return nil
+}
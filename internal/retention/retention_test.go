@@ -0,0 +1,69 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type logger struct{}
+
+func (l logger) Info(msg string, keysAndValues ...interface{}) {}
+
+func (l logger) Error(msg string, keysAndValues ...interface{}) {}
+
+type storage struct {
+	partitionsFrom time.Time
+	partitionsDays int
+	purgedBefore   time.Time
+	archive        bool
+	purgeCalls     int
+}
+
+func (s *storage) CreateEventPartitions(ctx context.Context, from time.Time, days int) error {
+	s.partitionsFrom = from
+	s.partitionsDays = days
+
+	return nil
+}
+
+func (s *storage) PurgeEvents(ctx context.Context, before time.Time, archive bool) (int, error) {
+	s.purgedBefore = before
+	s.archive = archive
+	s.purgeCalls++
+
+	return 1, nil
+}
+
+func TestJob(t *testing.T) {
+	now := time.Date(2022, 10, 27, 12, 0, 0, 0, time.UTC)
+
+	t.Run("test purge before retention period", func(t *testing.T) {
+		s := &storage{}
+		job := New(logger{}, s, config.RetentionConf{Period: 48 * time.Hour, Archive: true, PartitionsAhead: 3})
+		job.now = func() time.Time { return now }
+
+		err := job.Purge(context.Background())
+		require.NoError(t, err, "should be without errors")
+
+		require.Equal(t, now, s.partitionsFrom)
+		require.Equal(t, 3, s.partitionsDays)
+		require.Equal(t, now.Add(-48*time.Hour), s.purgedBefore)
+		require.True(t, s.archive)
+	})
+
+	t.Run("test zero period keeps events", func(t *testing.T) {
+		s := &storage{}
+		job := New(logger{}, s, config.RetentionConf{PartitionsAhead: 2})
+		job.now = func() time.Time { return now }
+
+		err := job.Purge(context.Background())
+		require.NoError(t, err, "should be without errors")
+
+		require.Equal(t, 2, s.partitionsDays, "partitions should be created")
+		require.Equal(t, 0, s.purgeCalls, "events should not be purged")
+	})
+}
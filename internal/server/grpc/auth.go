@@ -0,0 +1,86 @@
+package internalgrpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/VladimirButakov/otus-project/internal/auth"
+	gw "github.com/VladimirButakov/otus-project/internal/server/pb/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// apiKeyHeader is the metadata key of API keys, forwarded by the gateway from the X-Api-Key header.
+const apiKeyHeader = "x-api-key"
+
+// methodRoles are the roles required by the methods of the service, by full method name.
+var methodRoles = func() map[string]auth.Role {
+	roles := make(map[string]auth.Role)
+	service := gw.File_api_banner_proto.Services().ByName("BannersRotation")
+
+	for i := 0; i < service.Methods().Len(); i++ {
+		method := service.Methods().Get(i)
+		role := auth.RoleAdmin
+
+		if proto.GetExtension(method.Options(), gw.E_Role).(gw.Role) == gw.Role_ROLE_PUBLIC {
+			role = auth.RolePublic
+		}
+
+		roles[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = role
+	}
+
+	return roles
+}()
+
+// authorize is a unary interceptor which authenticates the caller by the API key or
+// the bearer token of the request and checks the caller has the role of the method.
+func (s *grpcserver) authorize(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if s.auth == nil || isHealthMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	principal, err := s.auth.Authenticate(firstValue(md, apiKeyHeader), bearerToken(firstValue(md, "authorization")))
+	if err != nil {
+		return nil, s.errorStatus("cannot authenticate", err)
+	}
+
+	role, ok := methodRoles[info.FullMethod]
+	if !ok {
+		role = auth.RoleAdmin
+	}
+
+	if !principal.Has(role) {
+		return nil, s.errorStatus("cannot call "+info.FullMethod, auth.ErrPermissionDenied)
+	}
+
+	return handler(auth.WithPrincipal(ctx, principal), req)
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// bearerToken returns the token of a bearer authorization. Other schemes are
+// returned as they are, so they fail verification instead of being anonymous.
+func bearerToken(authorization string) string {
+	const prefix = "bearer "
+
+	if len(authorization) > len(prefix) && strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return authorization[len(prefix):]
+	}
+
+	return authorization
+}
@@ -0,0 +1,18 @@
+package internalgrpc
+
+import (
+	"testing"
+
+	"github.com/VladimirButakov/otus-project/internal/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMethodRoles(t *testing.T) {
+	require.Equal(t, auth.RolePublic, methodRoles["/banner.BannersRotation/GetBanner"], "banners should be public")
+	require.Equal(t, auth.RolePublic, methodRoles["/banner.BannersRotation/ClickEvent"], "clicks should be public")
+	require.Equal(t, auth.RoleAdmin, methodRoles["/banner.BannersRotation/CreateBanner"], "admin role should be default")
+	require.Equal(t, auth.RoleAdmin, methodRoles["/banner.BannersRotation/AddBanner"], "admin role should be default")
+
+	require.Equal(t, "token", bearerToken("Bearer token"))
+	require.Equal(t, "Basic dXNlcg==", bearerToken("Basic dXNlcg=="), "other schemes should fail verification")
+}
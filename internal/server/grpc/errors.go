@@ -0,0 +1,116 @@
+package internalgrpc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/app"
+	"github.com/VladimirButakov/otus-project/internal/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// errorDomain is the domain of the google.rpc.ErrorInfo details of errors.
+const errorDomain = "banners-rotation"
+
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+var errorCodes = map[app.Code]codes.Code{
+	app.CodeInternal:           codes.Internal,
+	app.CodeInvalidArgument:    codes.InvalidArgument,
+	app.CodeNotFound:           codes.NotFound,
+	app.CodeAlreadyExists:      codes.AlreadyExists,
+	app.CodeFailedPrecondition: codes.FailedPrecondition,
+	app.CodeUnavailable:        codes.Unavailable,
+	app.CodeDeadlineExceeded:   codes.DeadlineExceeded,
+	app.CodeCanceled:           codes.Canceled,
+}
+
+// interceptorErrors lists the errors of the authorize and rateLimit interceptors.
+// They are not domain errors, so they are mapped here instead of by app.Classify.
+var interceptorErrors = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{auth.ErrUnauthenticated, codes.Unauthenticated, "UNAUTHENTICATED"},
+	{auth.ErrPermissionDenied, codes.PermissionDenied, "PERMISSION_DENIED"},
+	{ErrRateLimited, codes.ResourceExhausted, "RATE_LIMITED"},
+}
+
+// retryError is an error which the client may retry after delay.
+type retryError struct {
+	err   error
+	delay time.Duration
+}
+
+func (e *retryError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryError) Unwrap() error {
+	return e.err
+}
+
+// errorStatus converts an error to a grpc status with the code of its interceptor or
+// domain error, google.rpc.ErrorInfo and, for invalid fields, google.rpc.BadRequest
+// details. Causes of internal errors are logged instead of returned to clients.
+func (s *grpcserver) errorStatus(msg string, err error) error {
+	var (
+		code       codes.Code
+		reason     string
+		message    string
+		violations []app.FieldViolation
+	)
+
+	for _, item := range interceptorErrors {
+		if errors.Is(err, item.err) {
+			code, reason, message = item.code, item.reason, item.err.Error()
+
+			break
+		}
+	}
+
+	if reason == "" {
+		domainErr := app.Classify(err)
+
+		if domainErr.Code == app.CodeInternal || domainErr.Code == app.CodeUnavailable {
+			s.app.GetLogger().Error(fmt.Sprintf("%s, %s", msg, err))
+		}
+
+		code, reason, message, violations = errorCodes[domainErr.Code], domainErr.Reason, domainErr.Message, domainErr.Violations
+	}
+
+	st := status.New(code, fmt.Sprintf("%s, %s", msg, message))
+
+	details := []protoiface.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}}
+
+	if len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+
+		for _, violation := range violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+
+		details = append(details, badRequest)
+	}
+
+	var retryErr *retryError
+	if errors.As(err, &retryErr) && retryErr.delay > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryErr.delay)})
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
@@ -0,0 +1,61 @@
+package internalgrpc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/auth"
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorStatus(t *testing.T) {
+	s := &grpcserver{}
+
+	reason := func(st *status.Status) string {
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				return info.Reason
+			}
+		}
+
+		return ""
+	}
+
+	t.Run("test interceptor errors", func(t *testing.T) {
+		st := status.Convert(s.errorStatus("cannot authenticate", fmt.Errorf("cannot verify token, %w", auth.ErrUnauthenticated)))
+		require.Equal(t, codes.Unauthenticated, st.Code())
+		require.Equal(t, "UNAUTHENTICATED", reason(st))
+
+		st = status.Convert(s.errorStatus("cannot call method", auth.ErrPermissionDenied))
+		require.Equal(t, codes.PermissionDenied, st.Code())
+		require.Equal(t, "PERMISSION_DENIED", reason(st))
+	})
+
+	t.Run("test rate limited", func(t *testing.T) {
+		st := status.Convert(s.errorStatus("cannot call method", &retryError{err: ErrRateLimited, delay: 2 * time.Second}))
+		require.Equal(t, codes.ResourceExhausted, st.Code())
+		require.Equal(t, "RATE_LIMITED", reason(st))
+
+		var retryInfo *errdetails.RetryInfo
+
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				retryInfo = info
+			}
+		}
+
+		require.NotNil(t, retryInfo, "rate limited status should have retry info")
+		require.Equal(t, 2*time.Second, retryInfo.RetryDelay.AsDuration())
+	})
+
+	t.Run("test domain errors", func(t *testing.T) {
+		st := status.Convert(s.errorStatus("cannot read banner", fmt.Errorf("cannot get banner, %w", sqlstorage.ErrNotFound)))
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Equal(t, "NOT_FOUND", reason(st))
+	})
+}
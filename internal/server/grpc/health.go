@@ -0,0 +1,113 @@
+package internalgrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/app"
+	gw "github.com/VladimirButakov/otus-project/internal/server/pb/api"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ReadinessCheck returns an error while a dependency of the service is not reachable.
+type ReadinessCheck func(ctx context.Context) error
+
+const (
+	readinessInterval = 5 * time.Second
+	readinessTimeout  = 2 * time.Second
+)
+
+// isHealthMethod reports whether the method belongs to the grpc health service, which
+// is called by orchestrators without credentials.
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+type readiness struct {
+	checks map[string]ReadinessCheck
+	health *health.Server
+	logger app.Logger
+}
+
+type readinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// check runs the checks and returns whether all of them passed, with the result of each one.
+func (r *readiness) check(ctx context.Context) (bool, map[string]string) {
+	ready := true
+	results := make(map[string]string, len(r.checks))
+
+	for name, check := range r.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, readinessTimeout)
+		err := check(checkCtx)
+		cancel()
+
+		if err != nil {
+			ready = false
+			results[name] = err.Error()
+
+			continue
+		}
+
+		results[name] = "ok"
+	}
+
+	return ready, results
+}
+
+// watch sets the serving status of the grpc health service from the checks on every
+// interval until ctx is done.
+func (r *readiness) watch(ctx context.Context) {
+	ticker := time.NewTicker(readinessInterval)
+	defer ticker.Stop()
+
+	for {
+		ready, results := r.check(ctx)
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if !ready {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+
+			r.logger.Warn("service is not ready", "checks", results)
+		}
+
+		r.health.SetServingStatus("", status)
+		r.health.SetServingStatus(gw.BannersRotation_ServiceDesc.ServiceName, status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// healthz reports that the service is alive.
+func (r *readiness) healthz(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	writeReadiness(w, http.StatusOK, readinessResponse{Status: "ok"})
+}
+
+// readyz runs the checks and reports whether the service can handle requests.
+func (r *readiness) readyz(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	ready, results := r.check(req.Context())
+	if !ready {
+		writeReadiness(w, http.StatusServiceUnavailable, readinessResponse{Status: "unavailable", Checks: results})
+
+		return
+	}
+
+	writeReadiness(w, http.StatusOK, readinessResponse{Status: "ok", Checks: results})
+}
+
+func writeReadiness(w http.ResponseWriter, statusCode int, response readinessResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,52 @@
+package internalgrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadiness(t *testing.T) {
+	var amqpErr error
+
+	r := &readiness{checks: map[string]ReadinessCheck{
+		"storage": func(ctx context.Context) error { return nil },
+		"amqp":    func(ctx context.Context) error { return amqpErr },
+	}}
+
+	readyz := func() (int, readinessResponse) {
+		recorder := httptest.NewRecorder()
+		r.readyz(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil), nil)
+
+		var response readinessResponse
+
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response), "should be without errors")
+
+		return recorder.Code, response
+	}
+
+	t.Run("test ready", func(t *testing.T) {
+		code, response := readyz()
+		require.Equal(t, http.StatusOK, code, "service should be ready")
+		require.Equal(t, map[string]string{"storage": "ok", "amqp": "ok"}, response.Checks)
+	})
+
+	t.Run("test not ready", func(t *testing.T) {
+		amqpErr = errors.New("channel is closed")
+
+		code, response := readyz()
+		require.Equal(t, http.StatusServiceUnavailable, code, "service should not be ready")
+		require.Equal(t, "unavailable", response.Status)
+		require.Equal(t, "channel is closed", response.Checks["amqp"], "failed check should be returned")
+	})
+
+	t.Run("test health methods", func(t *testing.T) {
+		require.True(t, isHealthMethod("/grpc.health.v1.Health/Check"))
+		require.False(t, isHealthMethod("/banner.BannersRotation/GetBanner"))
+	})
+}
@@ -0,0 +1,35 @@
+package internalgrpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// observe is a unary interceptor which counts requests and observes their latency
+// by method and status code, including requests rejected by later interceptors.
+func observe(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err).String()
+	metrics.GRPCRequests.WithLabelValues(info.FullMethod, code).Inc()
+	metrics.GRPCDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+// serveMetrics serves the metrics in the Prometheus text format.
+func serveMetrics(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	metrics.Handler().ServeHTTP(w, req)
+}
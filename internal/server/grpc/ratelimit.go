@@ -0,0 +1,165 @@
+package internalgrpc
+
+import (
+	"context"
+	"math"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/auth"
+	"github.com/VladimirButakov/otus-project/internal/config"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// retryAfterHeader is the header metadata of rate limited responses, which the gateway returns as Retry-After.
+const retryAfterHeader = "retry-after"
+
+// rateLimiter keeps a token bucket for each method and client.
+type rateLimiter struct {
+	conf      config.RateLimitConf
+	methods   map[string]config.LimitConf
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+type bucketKey struct {
+	method string
+	client string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newRateLimiter(conf config.RateLimitConf) *rateLimiter {
+	methods := make(map[string]config.LimitConf, len(conf.Methods))
+	for method, limit := range conf.Methods {
+		methods[strings.ToLower(method)] = limit
+	}
+
+	return &rateLimiter{conf: conf, methods: methods, buckets: make(map[bucketKey]*bucket)}
+}
+
+// allow takes a token of the client from the bucket of the method. When there are
+// no tokens left, it returns false and the time until the next token.
+func (l *rateLimiter) allow(method string, client string, now time.Time) (bool, time.Duration) {
+	limit, ok := l.methods[strings.ToLower(method)]
+	if !ok {
+		limit = l.conf.Default
+	}
+
+	if limit.Rate <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	key := bucketKey{method: method, client: client}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		burst := limit.Burst
+		if burst < 1 {
+			burst = 1
+		}
+
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), burst)}
+		l.buckets[key] = b
+	}
+
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+
+		return false, delay
+	}
+
+	return true, 0
+}
+
+// sweep drops buckets of clients idle for the client TTL, at most once per TTL.
+func (l *rateLimiter) sweep(now time.Time) {
+	if l.conf.ClientTTL <= 0 || now.Sub(l.lastSweep) < l.conf.ClientTTL {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.conf.ClientTTL {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastSweep = now
+}
+
+// rateLimit is a unary interceptor which rejects requests of clients over the limit
+// of the method with ResourceExhausted and the retry-after header.
+func (s *grpcserver) rateLimit(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if s.limiter == nil || isHealthMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	allowed, delay := s.limiter.allow(path.Base(info.FullMethod), clientKey(ctx), time.Now())
+	if !allowed {
+		seconds := int(math.Ceil(delay.Seconds()))
+
+		if err := grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.Itoa(seconds))); err != nil {
+			s.app.GetLogger().Warn("cannot set retry-after header", "error", err)
+		}
+
+		return nil, s.errorStatus("cannot call "+info.FullMethod, &retryError{err: ErrRateLimited, delay: delay})
+	}
+
+	return handler(ctx, req)
+}
+
+// clientKey identifies the client by the principal authenticated by authorize, which
+// runs before the limiter, so unvalidated credentials cannot mint buckets. Anonymous
+// clients are identified by their IP. Behind the gateway, which connects from the
+// loopback, the IP is the last X-Forwarded-For entry, which the gateway sets to the
+// address of the HTTP client.
+func clientKey(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok && principal.Subject != "" {
+		return "principal:" + principal.Subject
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var ip net.IP
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if addr, ok := p.Addr.(*net.TCPAddr); ok {
+			ip = addr.IP
+		}
+	}
+
+	if ip == nil || ip.IsLoopback() {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			entries := strings.Split(forwarded[len(forwarded)-1], ",")
+
+			return "ip:" + strings.TrimSpace(entries[len(entries)-1])
+		}
+	}
+
+	return "ip:" + ip.String()
+}
@@ -0,0 +1,65 @@
+package internalgrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/auth"
+	"github.com/VladimirButakov/otus-project/internal/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(config.RateLimitConf{
+		Default:   config.LimitConf{Rate: 0},
+		Methods:   map[string]config.LimitConf{"clickevent": {Rate: 1, Burst: 2}},
+		ClientTTL: time.Minute,
+	})
+
+	t.Run("test token bucket", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			allowed, _ := limiter.allow("ClickEvent", "ip:10.0.0.1", now)
+			require.True(t, allowed, "burst should be allowed")
+		}
+
+		allowed, delay := limiter.allow("ClickEvent", "ip:10.0.0.1", now)
+		require.False(t, allowed, "requests over the burst should be limited")
+		require.Equal(t, time.Second, delay, "delay should be the time until the next token")
+
+		allowed, _ = limiter.allow("ClickEvent", "ip:10.0.0.2", now)
+		require.True(t, allowed, "clients should have own buckets")
+
+		allowed, _ = limiter.allow("ClickEvent", "ip:10.0.0.1", now.Add(time.Second))
+		require.True(t, allowed, "tokens should be refilled")
+
+		for i := 0; i < 10; i++ {
+			allowed, _ = limiter.allow("GetBanner", "ip:10.0.0.1", now)
+			require.True(t, allowed, "methods without rate should not be limited")
+		}
+	})
+
+	t.Run("test idle clients are dropped", func(t *testing.T) {
+		limiter.allow("ClickEvent", "ip:10.0.0.3", now.Add(2*time.Minute))
+		require.Len(t, limiter.buckets, 1, "idle buckets should be dropped")
+	})
+}
+
+func TestClientKey(t *testing.T) {
+	gateway := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}})
+	client := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1)}})
+	forwarded := metadata.Pairs("x-forwarded-for", "1.1.1.1, 10.0.0.2")
+
+	require.Equal(t, "ip:10.0.0.2", clientKey(metadata.NewIncomingContext(gateway, forwarded)), "gateway clients should be keyed by ip")
+	require.Equal(t, "ip:10.0.0.1", clientKey(metadata.NewIncomingContext(client, forwarded)), "forwarded ip of other peers should be ignored")
+	require.Equal(t, "ip:10.0.0.1", clientKey(metadata.NewIncomingContext(client, metadata.Pairs(apiKeyHeader, "k1"))),
+		"unvalidated api keys should be ignored")
+	require.Equal(t, "ip:10.0.0.1", clientKey(auth.WithPrincipal(client, auth.Principal{Role: auth.RolePublic})),
+		"anonymous clients should be keyed by ip")
+	require.Equal(t, "principal:site", clientKey(auth.WithPrincipal(client, auth.Principal{Subject: "site", Role: auth.RolePublic})),
+		"authenticated clients should be keyed by principal")
+}
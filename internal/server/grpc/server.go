@@ -6,32 +6,55 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/VladimirButakov/otus-project/internal/app"
+	"github.com/VladimirButakov/otus-project/internal/auth"
+	"github.com/VladimirButakov/otus-project/internal/config"
+	"github.com/VladimirButakov/otus-project/internal/server/openapi"
 	gw "github.com/VladimirButakov/otus-project/internal/server/pb/api"
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
 	"github.com/google/uuid"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Server struct {
-	app    app.App
-	server *http.Server
+	app       app.App
+	server    *http.Server
+	readiness *readiness
 }
 
 type grpcserver struct {
 	gw.UnimplementedBannersRotationServer
-	app app.App
-}
+	app     app.App
+	auth    *auth.Authenticator
+	limiter *rateLimiter
 
-var ErrBadRequest = errors.New("bad request")
+	clicksWithoutImpression bool
+}
 
-func NewServer(app *app.App, address string, port string, grpcPort string) (*Server, error) {
+// NewServer starts the grpc server and returns the gateway server. Requests are not
+// authenticated when authenticator is nil. The service is ready while all checks pass.
+// Clicks without an impression id are rejected unless clicksWithoutImpression is set.
+func NewServer(
+	app *app.App,
+	authenticator *auth.Authenticator,
+	rateLimit config.RateLimitConf,
+	checks map[string]ReadinessCheck,
+	clicksWithoutImpression bool,
+	address string,
+	port string,
+	grpcPort string,
+) (*Server, error) {
 	grpcServerEndpoint := net.JoinHostPort(address, grpcPort)
 
 	lis, err := net.Listen("tcp", grpcServerEndpoint)
@@ -40,15 +63,29 @@ func NewServer(app *app.App, address string, port string, grpcPort string) (*Ser
 	}
 
 	logger := app.GetLogger().GetInstance()
+	service := &grpcserver{app: *app, auth: authenticator, clicksWithoutImpression: clicksWithoutImpression}
+
+	if rateLimit.Enabled {
+		service.limiter = newRateLimiter(rateLimit)
+	}
 
 	s := grpc.NewServer(grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+		otelgrpc.StreamServerInterceptor(),
 		grpc_zap.StreamServerInterceptor(logger),
 	)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			otelgrpc.UnaryServerInterceptor(),
 			grpc_zap.UnaryServerInterceptor(logger),
+			observe,
+			service.authorize,
+			service.rateLimit,
+			service.validateRequest,
 		)))
 
-	gw.RegisterBannersRotationServer(s, &grpcserver{app: *app})
+	gw.RegisterBannersRotationServer(s, service)
+
+	ready := &readiness{checks: checks, health: health.NewServer(), logger: app.GetLogger()}
+	healthpb.RegisterHealthServer(s, ready.health)
 
 	go func() {
 		err := s.Serve(lis)
@@ -65,6 +102,8 @@ func NewServer(app *app.App, address string, port string, grpcPort string) (*Ser
 		grpcServerEndpoint,
 		grpc.WithBlock(),
 		grpc.WithInsecure(),
+		// The gateway passes the trace context of HTTP requests on to the grpc server.
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial server, %w", err)
@@ -72,24 +111,98 @@ func NewServer(app *app.App, address string, port string, grpcPort string) (*Ser
 
 	// Register gRPC server endpoint
 	// Note: Make sure the gRPC server is running properly and accessible
-	gwmux := runtime.NewServeMux()
+	gwmux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
 	err = gw.RegisterBannersRotationHandler(ctx, gwmux, conn)
 	if err != nil {
 		return nil, fmt.Errorf("cannot register app handler, %w", err)
 	}
 
+	if err := gwmux.HandlePath(http.MethodGet, "/healthz", ready.healthz); err != nil {
+		return nil, fmt.Errorf("cannot register health handler, %w", err)
+	}
+
+	if err := gwmux.HandlePath(http.MethodGet, "/readyz", ready.readyz); err != nil {
+		return nil, fmt.Errorf("cannot register readiness handler, %w", err)
+	}
+
+	if err := gwmux.HandlePath(http.MethodGet, "/metrics", serveMetrics); err != nil {
+		return nil, fmt.Errorf("cannot register metrics handler, %w", err)
+	}
+
+	if err := gwmux.HandlePath(http.MethodGet, "/api/openapi.json", serveFile("application/json", openapi.Spec)); err != nil {
+		return nil, fmt.Errorf("cannot register openapi handler, %w", err)
+	}
+
+	if err := gwmux.HandlePath(http.MethodGet, "/api/docs", serveFile("text/html; charset=utf-8", openapi.Docs)); err != nil {
+		return nil, fmt.Errorf("cannot register docs handler, %w", err)
+	}
+
+	docsAssets := map[string]runtime.HandlerFunc{
+		"/api/docs/swagger-ui.css":       serveFile("text/css; charset=utf-8", openapi.SwaggerUICSS),
+		"/api/docs/swagger-ui-bundle.js": serveFile("text/javascript; charset=utf-8", openapi.SwaggerUIBundle),
+	}
+
+	for assetPath, handler := range docsAssets {
+		if err := gwmux.HandlePath(http.MethodGet, assetPath, handler); err != nil {
+			return nil, fmt.Errorf("cannot register docs asset handler, %w", err)
+		}
+	}
+
 	// Start HTTP server (and proxy calls to gRPC server endpoint)
 	server := &http.Server{
 		Addr:         net.JoinHostPort(address, port),
-		Handler:      gwmux,
+		Handler:      otelhttp.NewHandler(gwmux, "gateway", otelhttp.WithFilter(traced)),
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
 	}
 
-	return &Server{*app, server}, nil
+	return &Server{*app, server, ready}, nil
+}
+
+// serveFile returns a handler which serves the embedded file with the content type.
+func serveFile(contentType string, content []byte) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", contentType)
+
+		_, _ = w.Write(content)
+	}
+}
+
+// traced reports whether gateway requests are traced, which probes, scrapes and docs are not.
+func traced(req *http.Request) bool {
+	switch req.URL.Path {
+	case "/healthz", "/readyz", "/metrics", "/api/openapi.json":
+		return false
+	default:
+		return !strings.HasPrefix(req.URL.Path, "/api/docs")
+	}
+}
+
+// headerMatcher forwards API keys to the grpc server along with the default headers.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, apiKeyHeader) {
+		return apiKeyHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher returns Retry-After as it is and other header metadata
+// with the default Grpc-Metadata- prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == retryAfterHeader {
+		return "Retry-After", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
 }
 
 func (s *Server) Start(ctx context.Context) error {
+	go s.readiness.watch(ctx)
+
 	err := s.server.ListenAndServe()
 	if err != nil {
 		if errors.Is(err, http.ErrServerClosed) {
@@ -103,6 +216,8 @@ func (s *Server) Start(ctx context.Context) error {
 }
 
 func (s *Server) Stop(ctx context.Context) error {
+	s.readiness.health.Shutdown()
+
 	if err := s.server.Shutdown(ctx); err != nil {
 		return fmt.Errorf("cannot shutdown gateway server, %w", err)
 	}
@@ -111,55 +226,77 @@ func (s *Server) Stop(ctx context.Context) error {
 }
 
 func (s *grpcserver) AddBanner(ctx context.Context, in *gw.AddBannerRequest) (*gw.MessageResponse, error) {
-	if in.BannerId == "" || in.SlotId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "cannot add banner in rotation, %s", ErrBadRequest)
-	}
-
-	err := s.app.AddBannerRotation(in.BannerId, in.SlotId)
+	err := s.app.AddBannerRotation(ctx, in.BannerId, in.SlotId, in.IfNotExists)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot add banner in rotation, %s", err)
+		return nil, s.errorStatus("cannot add banner in rotation", err)
 	}
 
 	return &gw.MessageResponse{Message: "added"}, nil
 }
 
 func (s *grpcserver) RemoveBanner(ctx context.Context, in *gw.RemoveBannerRequest) (*gw.MessageResponse, error) {
-	if in.BannerId == "" || in.SlotId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "cannot remove banner from rotation, %s", ErrBadRequest)
-	}
-
-	err := s.app.RemoveBannerRotation(in.BannerId, in.SlotId)
+	err := s.app.RemoveBannerRotation(ctx, in.BannerId, in.SlotId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "cannot remove banner from rotation, %s", err)
+		return nil, s.errorStatus("cannot remove banner from rotation", err)
 	}
 
 	return &gw.MessageResponse{Message: "removed"}, nil
 }
 
 func (s *grpcserver) ClickEvent(ctx context.Context, in *gw.ClickEventRequest) (*gw.MessageResponse, error) {
-	if in.BannerId == "" || in.SlotId == "" || in.SocialDemoId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "cannot click on banner, %s", ErrBadRequest)
+	// impression_id is required, unless clicks without impressions are accepted, which
+	// need all the other ids instead. Field rules cannot express either.
+	var violations app.Violations
+
+	switch {
+	case in.ImpressionId != "":
+	case s.clicksWithoutImpression:
+		violations.Required("banner_id", in.BannerId)
+		violations.Required("slot_id", in.SlotId)
+		violations.Required("social_demo_id", in.SocialDemoId)
+	default:
+		violations.Required("impression_id", in.ImpressionId)
+	}
+
+	if err := violations.Err(); err != nil {
+		return nil, s.errorStatus("cannot click on banner", err)
 	}
 
-	err := s.app.AddClickEvent(in.BannerId, in.SlotId, in.SocialDemoId)
+	err := s.app.AddClickEvent(ctx, in.BannerId, in.SlotId, in.SocialDemoId, in.UserId, in.ImpressionId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot add click event, %s", err)
+		return nil, s.errorStatus("cannot add click event", err)
 	}
 
 	return &gw.MessageResponse{Message: "clicked"}, nil
 }
 
 func (s *grpcserver) GetBanner(ctx context.Context, in *gw.GetBannerRequest) (*gw.BannerResponse, error) {
-	if in.SlotId == "" || in.SocialDemoId == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "cannot get banner, %s", ErrBadRequest)
+	banner, impressionID, err := s.app.GetBanner(ctx, in.SlotId, in.SocialDemoId, in.UserId)
+	if err != nil {
+		return nil, s.errorStatus("cannot get banners", err)
 	}
 
-	ID, err := s.app.GetBanner(in.SlotId, in.SocialDemoId)
+	return &gw.BannerResponse{Id: banner.ID, ImpressionId: impressionID, Creative: creativeMessage(banner.Creative)}, nil
+}
+
+func (s *grpcserver) GetBannersForPage(ctx context.Context, in *gw.GetBannersForPageRequest) (*gw.GetBannersForPageResponse, error) {
+	page, err := s.app.GetBannersForPage(ctx, in.SlotIds, in.SocialDemoId, in.UserId, in.Unique)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "cannot get banners, %s", err)
+		return nil, s.errorStatus("cannot get banners for page", err)
 	}
 
-	return &gw.BannerResponse{Id: ID}, nil
+	response := &gw.GetBannersForPageResponse{Banners: make([]*gw.PageBanner, 0, len(page))}
+
+	for _, banner := range page {
+		response.Banners = append(response.Banners, &gw.PageBanner{
+			SlotId:       banner.SlotID,
+			BannerId:     banner.BannerID,
+			ImpressionId: banner.ImpressionID,
+			Creative:     creativeMessage(banner.Creative),
+		})
+	}
+
+	return response, nil
 }
 
 func (s *grpcserver) CreateBanner(ctx context.Context, in *gw.BannerRequest) (*gw.BannerResponse, error) {
@@ -169,9 +306,9 @@ func (s *grpcserver) CreateBanner(ctx context.Context, in *gw.BannerRequest) (*g
 		ID = uuid.NewString()
 	}
 
-	ID, err := s.app.CreateBanner(ID, in.Description)
+	ID, err := s.app.CreateBanner(ctx, ID, in.Description, creative(in.Creative), in.IfNotExists)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create banner, %s", err)
+		return nil, s.errorStatus("cannot create banner", err)
 	}
 
 	return &gw.BannerResponse{Id: ID}, nil
@@ -184,9 +321,9 @@ func (s *grpcserver) CreateSlot(ctx context.Context, in *gw.SlotRequest) (*gw.Sl
 		ID = uuid.NewString()
 	}
 
-	ID, err := s.app.CreateSlot(ID, in.Description)
+	ID, err := s.app.CreateSlot(ctx, ID, in.Description, sizes(in.Sizes), in.IfNotExists)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create slot, %s", err)
+		return nil, s.errorStatus("cannot create slot", err)
 	}
 	return &gw.SlotResponse{Id: ID}, nil
 }
@@ -198,10 +335,379 @@ func (s *grpcserver) CreateSocialDemo(ctx context.Context, in *gw.SocialDemoRequ
 		ID = uuid.NewString()
 	}
 
-	ID, err := s.app.CreateSocialDemo(ID, in.Description)
+	ID, err := s.app.CreateSocialDemo(ctx, ID, in.Description, in.IfNotExists)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create social demo, %s", err)
+		return nil, s.errorStatus("cannot create social demo", err)
 	}
 
 	return &gw.SocialDemoResponse{Id: ID}, nil
 }
+
+func (s *grpcserver) ReadBanner(ctx context.Context, in *gw.ReadRequest) (*gw.Banner, error) {
+	banner, err := s.app.ReadBanner(ctx, in.Id)
+	if err != nil {
+		return nil, s.errorStatus("cannot read banner", err)
+	}
+
+	return bannerMessage(banner), nil
+}
+
+func (s *grpcserver) ListBanners(ctx context.Context, in *gw.ListRequest) (*gw.BannersResponse, error) {
+	banners, next, err := s.app.ListBanners(ctx, listFilter(in))
+	if err != nil {
+		return nil, s.errorStatus("cannot list banners", err)
+	}
+
+	response := &gw.BannersResponse{Banners: make([]*gw.Banner, 0, len(banners)), NextPageToken: next}
+
+	for _, banner := range banners {
+		response.Banners = append(response.Banners, bannerMessage(banner))
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) UpdateBanner(ctx context.Context, in *gw.BannerRequest) (*gw.Banner, error) {
+	// Field rules of the request are shared with create, where id is optional.
+	var violations app.Violations
+
+	violations.Required("id", in.Id)
+
+	if err := violations.Err(); err != nil {
+		return nil, s.errorStatus("cannot update banner", err)
+	}
+
+	fields, err := updateFields(in, in.UpdateMask)
+	if err != nil {
+		return nil, s.errorStatus("cannot update banner", err)
+	}
+
+	banner, err := s.app.UpdateBanner(ctx, in.Id,
+		sqlstorage.BannerItem{Description: in.Description, Creative: creative(in.Creative)}, fields)
+	if err != nil {
+		return nil, s.errorStatus("cannot update banner", err)
+	}
+
+	return bannerMessage(banner), nil
+}
+
+func (s *grpcserver) DeleteBanner(ctx context.Context, in *gw.DeleteRequest) (*gw.MessageResponse, error) {
+	err := s.app.DeleteBanner(ctx, in.Id, in.Force)
+	if err != nil {
+		return nil, s.errorStatus("cannot delete banner", err)
+	}
+
+	return &gw.MessageResponse{Message: "deleted"}, nil
+}
+
+func (s *grpcserver) ReadSlot(ctx context.Context, in *gw.ReadRequest) (*gw.Slot, error) {
+	slot, err := s.app.ReadSlot(ctx, in.Id)
+	if err != nil {
+		return nil, s.errorStatus("cannot read slot", err)
+	}
+
+	return slotMessage(slot), nil
+}
+
+func (s *grpcserver) ListSlots(ctx context.Context, in *gw.ListRequest) (*gw.SlotsResponse, error) {
+	slots, next, err := s.app.ListSlots(ctx, listFilter(in))
+	if err != nil {
+		return nil, s.errorStatus("cannot list slots", err)
+	}
+
+	response := &gw.SlotsResponse{Slots: make([]*gw.Slot, 0, len(slots)), NextPageToken: next}
+
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, slotMessage(slot))
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) UpdateSlot(ctx context.Context, in *gw.SlotRequest) (*gw.Slot, error) {
+	// Field rules of the request are shared with create, where id is optional.
+	var violations app.Violations
+
+	violations.Required("id", in.Id)
+
+	if err := violations.Err(); err != nil {
+		return nil, s.errorStatus("cannot update slot", err)
+	}
+
+	fields, err := updateFields(in, in.UpdateMask)
+	if err != nil {
+		return nil, s.errorStatus("cannot update slot", err)
+	}
+
+	slot, err := s.app.UpdateSlot(ctx, in.Id, sqlstorage.SlotItem{Description: in.Description, Sizes: sizes(in.Sizes)}, fields)
+	if err != nil {
+		return nil, s.errorStatus("cannot update slot", err)
+	}
+
+	return slotMessage(slot), nil
+}
+
+func (s *grpcserver) DeleteSlot(ctx context.Context, in *gw.DeleteRequest) (*gw.MessageResponse, error) {
+	err := s.app.DeleteSlot(ctx, in.Id, in.Force)
+	if err != nil {
+		return nil, s.errorStatus("cannot delete slot", err)
+	}
+
+	return &gw.MessageResponse{Message: "deleted"}, nil
+}
+
+func (s *grpcserver) ReadSocialDemo(ctx context.Context, in *gw.ReadRequest) (*gw.SocialDemo, error) {
+	socialDemo, err := s.app.ReadSocialDemo(ctx, in.Id)
+	if err != nil {
+		return nil, s.errorStatus("cannot read social demo", err)
+	}
+
+	return socialDemoMessage(socialDemo), nil
+}
+
+func (s *grpcserver) ListSocialDemos(ctx context.Context, in *gw.ListRequest) (*gw.SocialDemosResponse, error) {
+	socialDemos, next, err := s.app.ListSocialDemos(ctx, listFilter(in))
+	if err != nil {
+		return nil, s.errorStatus("cannot list social demos", err)
+	}
+
+	response := &gw.SocialDemosResponse{SocialDemos: make([]*gw.SocialDemo, 0, len(socialDemos)), NextPageToken: next}
+
+	for _, socialDemo := range socialDemos {
+		response.SocialDemos = append(response.SocialDemos, socialDemoMessage(socialDemo))
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) UpdateSocialDemo(ctx context.Context, in *gw.SocialDemoRequest) (*gw.SocialDemo, error) {
+	// Field rules of the request are shared with create, where id is optional.
+	var violations app.Violations
+
+	violations.Required("id", in.Id)
+
+	if err := violations.Err(); err != nil {
+		return nil, s.errorStatus("cannot update social demo", err)
+	}
+
+	err := s.app.UpdateSocialDemo(ctx, in.Id, in.Description)
+	if err != nil {
+		return nil, s.errorStatus("cannot update social demo", err)
+	}
+
+	return &gw.SocialDemo{Id: in.Id, Description: in.Description}, nil
+}
+
+func (s *grpcserver) DeleteSocialDemo(ctx context.Context, in *gw.DeleteRequest) (*gw.MessageResponse, error) {
+	err := s.app.DeleteSocialDemo(ctx, in.Id, in.Force)
+	if err != nil {
+		return nil, s.errorStatus("cannot delete social demo", err)
+	}
+
+	return &gw.MessageResponse{Message: "deleted"}, nil
+}
+
+func bannerMessage(banner sqlstorage.BannerItem) *gw.Banner {
+	return &gw.Banner{
+		Id:          banner.ID,
+		Description: banner.Description,
+		CreatedAt:   timestamppb.New(banner.CreatedAt),
+		Creative:    creativeMessage(banner.Creative),
+	}
+}
+
+func slotMessage(slot sqlstorage.SlotItem) *gw.Slot {
+	message := &gw.Slot{Id: slot.ID, Description: slot.Description, CreatedAt: timestamppb.New(slot.CreatedAt)}
+
+	for _, size := range slot.Sizes {
+		message.Sizes = append(message.Sizes, &gw.Size{Width: int32(size.Width), Height: int32(size.Height)})
+	}
+
+	return message
+}
+
+func creativeMessage(creative sqlstorage.Creative) *gw.Creative {
+	return &gw.Creative{
+		ImageUrl:   creative.ImageURL,
+		LandingUrl: creative.LandingURL,
+		AltText:    creative.AltText,
+		Width:      int32(creative.Width),
+		Height:     int32(creative.Height),
+		MimeType:   creative.MimeType,
+	}
+}
+
+func creative(in *gw.Creative) sqlstorage.Creative {
+	return sqlstorage.Creative{
+		ImageURL:   in.GetImageUrl(),
+		LandingURL: in.GetLandingUrl(),
+		AltText:    in.GetAltText(),
+		Width:      int(in.GetWidth()),
+		Height:     int(in.GetHeight()),
+		MimeType:   in.GetMimeType(),
+	}
+}
+
+func sizes(in []*gw.Size) []sqlstorage.Size {
+	result := make([]sqlstorage.Size, 0, len(in))
+
+	for _, size := range in {
+		result = append(result, sqlstorage.Size{Width: int(size.Width), Height: int(size.Height)})
+	}
+
+	return result
+}
+
+func socialDemoMessage(socialDemo sqlstorage.SocialDemoItem) *gw.SocialDemo {
+	return &gw.SocialDemo{Id: socialDemo.ID, Description: socialDemo.Description, CreatedAt: timestamppb.New(socialDemo.CreatedAt)}
+}
+
+func (s *grpcserver) ListRotations(ctx context.Context, in *gw.ListRequest) (*gw.RotationsResponse, error) {
+	rotations, next, err := s.app.ListRotations(ctx, listFilter(in))
+	if err != nil {
+		return nil, s.errorStatus("cannot list rotations", err)
+	}
+
+	response := &gw.RotationsResponse{Rotations: make([]*gw.Rotation, 0, len(rotations)), NextPageToken: next}
+
+	for _, rotation := range rotations {
+		message := &gw.Rotation{
+			SlotId:     rotation.SlotID,
+			BannerId:   rotation.BannerID,
+			CreatedAt:  timestamppb.New(rotation.CreatedAt),
+			TimeZone:   rotation.TimeZone,
+			CapPerHour: int32(rotation.PerHour),
+			CapPerDay:  int32(rotation.PerDay),
+		}
+
+		if rotation.StartsAt.Valid {
+			message.StartsAt = timestamppb.New(rotation.StartsAt.Time)
+		}
+
+		if rotation.EndsAt.Valid {
+			message.EndsAt = timestamppb.New(rotation.EndsAt.Time)
+		}
+
+		response.Rotations = append(response.Rotations, message)
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) ScheduleRotation(ctx context.Context, in *gw.ScheduleRotationRequest) (*gw.MessageResponse, error) {
+	schedule := sqlstorage.Schedule{TimeZone: in.TimeZone}
+
+	if in.StartsAt != nil {
+		schedule.StartsAt = in.StartsAt.AsTime()
+	}
+
+	if in.EndsAt != nil {
+		schedule.EndsAt = in.EndsAt.AsTime()
+	}
+
+	err := s.app.ScheduleBannerRotation(ctx, in.BannerId, in.SlotId, schedule)
+	if err != nil {
+		return nil, s.errorStatus("cannot schedule rotation", err)
+	}
+
+	return &gw.MessageResponse{Message: "scheduled"}, nil
+}
+
+func (s *grpcserver) SetFrequencyCap(ctx context.Context, in *gw.FrequencyCapRequest) (*gw.MessageResponse, error) {
+	frequencyCap := sqlstorage.FrequencyCap{PerHour: int(in.PerHour), PerDay: int(in.PerDay)}
+
+	err := s.app.SetFrequencyCap(ctx, in.BannerId, in.SlotId, frequencyCap)
+	if err != nil {
+		return nil, s.errorStatus("cannot set frequency cap", err)
+	}
+
+	return &gw.MessageResponse{Message: "capped"}, nil
+}
+
+func (s *grpcserver) GetTargeting(ctx context.Context, in *gw.RotationRequest) (*gw.Targeting, error) {
+	targeting, err := s.app.GetTargeting(ctx, in.BannerId, in.SlotId)
+	if err != nil {
+		return nil, s.errorStatus("cannot get targeting", err)
+	}
+
+	return &gw.Targeting{
+		BannerId:             in.BannerId,
+		SlotId:               in.SlotId,
+		IncludeSocialDemoIds: targeting.Include,
+		ExcludeSocialDemoIds: targeting.Exclude,
+	}, nil
+}
+
+func (s *grpcserver) SetTargeting(ctx context.Context, in *gw.Targeting) (*gw.Targeting, error) {
+	targeting := sqlstorage.Targeting{Include: in.IncludeSocialDemoIds, Exclude: in.ExcludeSocialDemoIds}
+
+	err := s.app.SetTargeting(ctx, in.BannerId, in.SlotId, targeting)
+	if err != nil {
+		return nil, s.errorStatus("cannot set targeting", err)
+	}
+
+	return in, nil
+}
+
+func listFilter(in *gw.ListRequest) sqlstorage.ListFilter {
+	filter := sqlstorage.ListFilter{
+		PageSize:    int(in.PageSize),
+		PageToken:   in.PageToken,
+		Description: in.Description,
+		SlotID:      in.SlotId,
+		Status:      sqlstorage.Status(in.Status),
+		OrderBy:     sqlstorage.SortOrder(in.OrderBy),
+	}
+
+	if in.CreatedAfter != nil {
+		filter.CreatedAfter = in.CreatedAfter.AsTime()
+	}
+
+	if in.CreatedBefore != nil {
+		filter.CreatedBefore = in.CreatedBefore.AsTime()
+	}
+
+	return filter
+}
+
+func (s *grpcserver) GetStats(ctx context.Context, in *gw.StatsRequest) (*gw.StatsResponse, error) {
+	filter := sqlstorage.StatsFilter{
+		SlotID:       in.SlotId,
+		SocialDemoID: in.SocialDemoId,
+		GroupBy:      sqlstorage.StatsGrouping(in.GroupBy),
+	}
+
+	if in.From != nil {
+		filter.From = in.From.AsTime()
+	}
+
+	if in.To != nil {
+		filter.To = in.To.AsTime()
+	}
+
+	stats, err := s.app.GetStats(ctx, filter)
+	if err != nil {
+		return nil, s.errorStatus("cannot get stats", err)
+	}
+
+	response := &gw.StatsResponse{Stats: make([]*gw.BannerStats, 0, len(stats))}
+
+	for _, item := range stats {
+		bannerStats := &gw.BannerStats{
+			BannerId: item.BannerID,
+			Views:    int64(item.Views),
+			Clicks:   int64(item.Clicks),
+			Ctr:      item.CTR,
+			CtrLower: item.CTRLower,
+			CtrUpper: item.CTRUpper,
+		}
+
+		if !item.Period.IsZero() {
+			bannerStats.PeriodStart = timestamppb.New(item.Period)
+		}
+
+		response.Stats = append(response.Stats, bannerStats)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,92 @@
+package internalgrpc
+
+import (
+	"github.com/VladimirButakov/otus-project/internal/app"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// notUpdated are the fields of update requests which are not entity fields.
+var notUpdated = map[protoreflect.Name]bool{"id": true, "if_not_exists": true, "update_mask": true}
+
+// updateFields returns the entity fields set by an update request: the paths of the
+// update mask, or without a mask the fields which are set in the request. Fields of
+// nested messages are entity fields of their own, so "creative" sets all of them.
+func updateFields(in protoreflect.ProtoMessage, mask *fieldmaskpb.FieldMask) ([]string, error) {
+	message := in.ProtoReflect()
+
+	if len(mask.GetPaths()) == 0 {
+		return setFields(message), nil
+	}
+
+	paths := make(map[string][]string)
+	maskPaths(message.Descriptor(), "", paths)
+
+	var (
+		violations app.Violations
+		fields     []string
+	)
+
+	seen := make(map[string]bool)
+
+	for _, path := range mask.GetPaths() {
+		pathFields, ok := paths[path]
+		if !ok {
+			violations.Add("update_mask", "has unknown field "+path)
+
+			continue
+		}
+
+		for _, field := range pathFields {
+			if !seen[field] {
+				seen[field] = true
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	return fields, violations.Err()
+}
+
+// setFields returns the entity fields which are set in the message.
+func setFields(message protoreflect.Message) []string {
+	var fields []string
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case notUpdated[field.Name()]:
+		case field.Kind() == protoreflect.MessageKind && !field.IsList():
+			fields = append(fields, setFields(value.Message())...)
+		default:
+			fields = append(fields, string(field.Name()))
+		}
+
+		return true
+	})
+
+	return fields
+}
+
+// maskPaths adds the mask paths of the message with the entity fields they set.
+func maskPaths(message protoreflect.MessageDescriptor, prefix string, paths map[string][]string) []string {
+	var all []string
+
+	for i := 0; i < message.Fields().Len(); i++ {
+		field := message.Fields().Get(i)
+		if notUpdated[field.Name()] {
+			continue
+		}
+
+		path := prefix + string(field.Name())
+
+		if field.Kind() == protoreflect.MessageKind && !field.IsList() {
+			paths[path] = maskPaths(field.Message(), path+".", paths)
+		} else {
+			paths[path] = []string{string(field.Name())}
+		}
+
+		all = append(all, paths[path]...)
+	}
+
+	return all
+}
@@ -0,0 +1,43 @@
+package internalgrpc
+
+import (
+	"testing"
+
+	"github.com/VladimirButakov/otus-project/internal/app"
+	gw "github.com/VladimirButakov/otus-project/internal/server/pb/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateFields(t *testing.T) {
+	t.Run("test set fields", func(t *testing.T) {
+		fields, err := updateFields(&gw.BannerRequest{Id: "banner1", Description: "description"}, nil)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, []string{"description"}, fields, "description only update should not set the creative")
+
+		in := &gw.BannerRequest{Id: "banner1", Creative: &gw.Creative{ImageUrl: "https://example.com/1.png", Width: 300}}
+
+		fields, err = updateFields(in, nil)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, []string{"image_url", "width"}, fields, "set creative fields should be updated")
+	})
+
+	t.Run("test update mask", func(t *testing.T) {
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"creative", "creative.width", "description"}}
+
+		fields, err := updateFields(&gw.BannerRequest{Id: "banner1"}, mask)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, []string{"image_url", "landing_url", "alt_text", "width", "height", "mime_type", "description"}, fields)
+
+		fields, err = updateFields(&gw.SlotRequest{Id: "slot1"}, &fieldmaskpb.FieldMask{Paths: []string{"sizes"}})
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, []string{"sizes"}, fields, "sizes should be cleared by mask")
+
+		_, err = updateFields(&gw.SlotRequest{Id: "slot1"}, &fieldmaskpb.FieldMask{Paths: []string{"id"}})
+
+		var appErr *app.Error
+
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, "update_mask", appErr.Violations[0].Field, "unknown paths should be invalid")
+	})
+}
@@ -0,0 +1,119 @@
+package internalgrpc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/VladimirButakov/otus-project/internal/app"
+	gw "github.com/VladimirButakov/otus-project/internal/server/pb/api"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// patterns caches compiled patterns of the validation rules.
+var patterns sync.Map
+
+// validateRequest is a unary interceptor which checks the request against the
+// validation rules declared on its fields in api/validate.proto.
+func (s *grpcserver) validateRequest(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if message, ok := req.(proto.Message); ok {
+		var violations app.Violations
+
+		validateMessage(&violations, "", message.ProtoReflect())
+
+		if err := violations.Err(); err != nil {
+			return nil, s.errorStatus("cannot validate request", err)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// validateMessage adds violations of the rules of the message fields, including
+// fields of nested messages, which are named with the path of the field.
+func validateMessage(violations *app.Violations, prefix string, message protoreflect.Message) {
+	fields := message.Descriptor().Fields()
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := prefix + string(field.Name())
+		rules, _ := proto.GetExtension(field.Options(), gw.E_Rules).(*gw.Rules)
+
+		if field.IsList() {
+			list := message.Get(field).List()
+
+			if rules != nil {
+				validateLen(violations, name, list.Len(), rules, "items")
+			}
+
+			for j := 0; j < list.Len(); j++ {
+				validateValue(violations, fmt.Sprintf("%s[%d]", name, j), field, list.Get(j), rules.GetItems())
+			}
+
+			continue
+		}
+
+		if field.Message() != nil && !message.Has(field) {
+			continue
+		}
+
+		validateValue(violations, name, field, message.Get(field), rules)
+	}
+}
+
+func validateValue(violations *app.Violations, name string, field protoreflect.FieldDescriptor, value protoreflect.Value, rules *gw.Rules) {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		validateMessage(violations, name+".", value.Message())
+	case protoreflect.StringKind:
+		if rules != nil {
+			validateString(violations, name, value.String(), rules)
+		}
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		if rules != nil && rules.Min != nil && value.Int() < rules.GetMin() {
+			violations.Add(name, fmt.Sprintf("must be at least %d", rules.GetMin()))
+		}
+	}
+}
+
+func validateString(violations *app.Violations, name string, value string, rules *gw.Rules) {
+	validateLen(violations, name, utf8.RuneCountInString(value), rules, "characters")
+
+	if value == "" || rules.GetPattern() == "" {
+		return
+	}
+
+	if !pattern(rules.GetPattern()).MatchString(value) {
+		violations.Add(name, fmt.Sprintf("must match %s", rules.GetPattern()))
+	}
+}
+
+func validateLen(violations *app.Violations, name string, length int, rules *gw.Rules, unit string) {
+	switch {
+	case rules.GetRequired() && length == 0:
+		violations.Add(name, "is required")
+	case rules.GetMaxLen() > 0 && length > int(rules.GetMaxLen()):
+		violations.Add(name, fmt.Sprintf("must have at most %d %s", rules.GetMaxLen(), unit))
+	}
+}
+
+func pattern(expr string) *regexp.Regexp {
+	if re, ok := patterns.Load(expr); ok {
+		return re.(*regexp.Regexp)
+	}
+
+	re := regexp.MustCompile(expr)
+	patterns.Store(expr, re)
+
+	return re
+}
@@ -0,0 +1,55 @@
+package internalgrpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/VladimirButakov/otus-project/internal/app"
+	gw "github.com/VladimirButakov/otus-project/internal/server/pb/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMessage(t *testing.T) {
+	t.Run("test valid request", func(t *testing.T) {
+		var violations app.Violations
+
+		validateMessage(&violations, "", (&gw.BannerRequest{
+			Id:          "banner_1",
+			Description: "Spring sale\nall week",
+			Creative:    &gw.Creative{ImageUrl: "https://cdn.example.com/1.png", Width: 300, Height: 250, MimeType: "image/png"},
+		}).ProtoReflect())
+		require.Empty(t, violations, "should be without violations")
+	})
+
+	t.Run("test field violations", func(t *testing.T) {
+		var violations app.Violations
+
+		validateMessage(&violations, "", (&gw.BannerRequest{
+			Id:          "banner 1",
+			Description: strings.Repeat("a", 1025),
+			Creative:    &gw.Creative{ImageUrl: "ftp://cdn.example.com/1.png", Width: -1},
+		}).ProtoReflect())
+
+		fields := make([]string, 0, len(violations))
+		for _, violation := range violations {
+			fields = append(fields, violation.Field)
+		}
+
+		require.Equal(t, []string{"id", "description", "creative.image_url", "creative.width"}, fields)
+	})
+
+	t.Run("test repeated field violations", func(t *testing.T) {
+		var violations app.Violations
+
+		validateMessage(&violations, "", (&gw.GetBannersForPageRequest{SlotIds: []string{"slot1", ""}}).ProtoReflect())
+		require.Equal(t, app.Violations{
+			{Field: "slot_ids[1]", Description: "is required"},
+			{Field: "social_demo_id", Description: "is required"},
+		}, violations)
+
+		violations = nil
+
+		validateMessage(&violations, "", (&gw.SlotRequest{Sizes: []*gw.Size{{Width: 300, Height: 0}}}).ProtoReflect())
+		require.Equal(t, app.Violations{{Field: "sizes[0].height", Description: "must be at least 1"}}, violations)
+	})
+}
@@ -0,0 +1,27 @@
+// Package openapi embeds the OpenAPI v2 specification of the gateway, which is
+// generated from the annotations of api/banner.proto, and its docs page with the
+// Swagger UI assets.
+package openapi
+
+import (
+	_ "embed" // the specification, the docs page and its assets are embedded into the binary
+)
+
+// Spec is the OpenAPI v2 specification of the gateway in JSON.
+//
+//go:embed openapi.swagger.json
+var Spec []byte
+
+// Docs is a Swagger UI page of the specification served at /api/openapi.json.
+//
+//go:embed docs.html
+var Docs []byte
+
+// SwaggerUICSS and SwaggerUIBundle are the assets of swagger-ui-dist 4.15.5, which the
+// docs page loads from the gateway instead of a CDN.
+//
+//go:embed swagger-ui/swagger-ui.css
+var SwaggerUICSS []byte
+
+//go:embed swagger-ui/swagger-ui-bundle.js
+var SwaggerUIBundle []byte
@@ -0,0 +1,41 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	gw "github.com/VladimirButakov/otus-project/internal/server/pb/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec(t *testing.T) {
+	var spec struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+	}
+
+	require.NoError(t, json.Unmarshal(Spec, &spec), "should be without errors")
+
+	operations := make(map[string]bool)
+
+	for _, methods := range spec.Paths {
+		for _, operation := range methods {
+			operations[operation.OperationID] = true
+		}
+	}
+
+	service := gw.File_api_banner_proto.Services().ByName("BannersRotation")
+
+	for i := 0; i < service.Methods().Len(); i++ {
+		name := "BannersRotation_" + string(service.Methods().Get(i).Name())
+		require.True(t, operations[name], "spec should describe %s, regenerate it with make generate-openapi", name)
+	}
+}
+
+func TestDocs(t *testing.T) {
+	require.NotContains(t, string(Docs), "https://", "docs page should load only embedded assets")
+	require.Contains(t, string(Docs), "/api/docs/swagger-ui-bundle.js")
+	require.Contains(t, string(SwaggerUIBundle), "SwaggerUIBundle", "swagger ui bundle should be embedded")
+	require.NotEmpty(t, SwaggerUICSS, "swagger ui styles should be embedded")
+}
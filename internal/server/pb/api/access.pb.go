@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: api/access.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Role is the role a caller needs for a method. The admin role grants every role.
+type Role int32
+
+const (
+	// Methods without a role require the admin role.
+	Role_ROLE_UNSPECIFIED Role = 0
+	Role_ROLE_PUBLIC      Role = 1
+	Role_ROLE_ADMIN       Role = 2
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_PUBLIC",
+		2: "ROLE_ADMIN",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_PUBLIC":      1,
+		"ROLE_ADMIN":       2,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_access_proto_enumTypes[0].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_api_access_proto_enumTypes[0]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_api_access_proto_rawDescGZIP(), []int{0}
+}
+
+var file_api_access_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Role)(nil),
+		Field:         51001,
+		Name:          "banner.role",
+		Tag:           "varint,51001,opt,name=role,enum=banner.Role",
+		Filename:      "api/access.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional banner.Role role = 51001;
+	E_Role = &file_api_access_proto_extTypes[0]
+)
+
+var File_api_access_proto protoreflect.FileDescriptor
+
+var file_api_access_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x2c, 0x74, 0x68, 0x69, 0x72,
+	0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x3d, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x50,
+	0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
+	0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x3a, 0x42, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xb9, 0x8e, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e,
+	0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_access_proto_rawDescOnce sync.Once
+	file_api_access_proto_rawDescData = file_api_access_proto_rawDesc
+)
+
+func file_api_access_proto_rawDescGZIP() []byte {
+	file_api_access_proto_rawDescOnce.Do(func() {
+		file_api_access_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_access_proto_rawDescData)
+	})
+	return file_api_access_proto_rawDescData
+}
+
+var file_api_access_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_access_proto_goTypes = []interface{}{
+	(Role)(0),                          // 0: banner.Role
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+}
+var file_api_access_proto_depIdxs = []int32{
+	1, // 0: banner.role:extendee -> google.protobuf.MethodOptions
+	0, // 1: banner.role:type_name -> banner.Role
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_access_proto_init() }
+func file_api_access_proto_init() {
+	if File_api_access_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_access_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_access_proto_goTypes,
+		DependencyIndexes: file_api_access_proto_depIdxs,
+		EnumInfos:         file_api_access_proto_enumTypes,
+		ExtensionInfos:    file_api_access_proto_extTypes,
+	}.Build()
+	File_api_access_proto = out.File
+	file_api_access_proto_rawDesc = nil
+	file_api_access_proto_goTypes = nil
+	file_api_access_proto_depIdxs = nil
+}
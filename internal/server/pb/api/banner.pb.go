@@ -7,9 +7,12 @@
 package pb
 
 import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +24,157 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	// In an active rotation entry of at least one slot.
+	Status_STATUS_ACTIVE   Status = 1
+	Status_STATUS_INACTIVE Status = 2
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACTIVE",
+		2: "STATUS_INACTIVE",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_ACTIVE":      1,
+		"STATUS_INACTIVE":    2,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_banner_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_api_banner_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{0}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_ID              SortOrder = 0
+	SortOrder_SORT_ORDER_ID_DESC         SortOrder = 1
+	SortOrder_SORT_ORDER_CREATED_AT      SortOrder = 2
+	SortOrder_SORT_ORDER_CREATED_AT_DESC SortOrder = 3
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_ID",
+		1: "SORT_ORDER_ID_DESC",
+		2: "SORT_ORDER_CREATED_AT",
+		3: "SORT_ORDER_CREATED_AT_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_ID":              0,
+		"SORT_ORDER_ID_DESC":         1,
+		"SORT_ORDER_CREATED_AT":      2,
+		"SORT_ORDER_CREATED_AT_DESC": 3,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_banner_proto_enumTypes[1].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_api_banner_proto_enumTypes[1]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{1}
+}
+
+type StatsGrouping int32
+
+const (
+	StatsGrouping_STATS_GROUPING_NONE StatsGrouping = 0
+	StatsGrouping_STATS_GROUPING_HOUR StatsGrouping = 1
+	StatsGrouping_STATS_GROUPING_DAY  StatsGrouping = 2
+)
+
+// Enum value maps for StatsGrouping.
+var (
+	StatsGrouping_name = map[int32]string{
+		0: "STATS_GROUPING_NONE",
+		1: "STATS_GROUPING_HOUR",
+		2: "STATS_GROUPING_DAY",
+	}
+	StatsGrouping_value = map[string]int32{
+		"STATS_GROUPING_NONE": 0,
+		"STATS_GROUPING_HOUR": 1,
+		"STATS_GROUPING_DAY":  2,
+	}
+)
+
+func (x StatsGrouping) Enum() *StatsGrouping {
+	p := new(StatsGrouping)
+	*p = x
+	return p
+}
+
+func (x StatsGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_banner_proto_enumTypes[2].Descriptor()
+}
+
+func (StatsGrouping) Type() protoreflect.EnumType {
+	return &file_api_banner_proto_enumTypes[2]
+}
+
+func (x StatsGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsGrouping.Descriptor instead.
+func (StatsGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{2}
+}
+
 type MessageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -68,12 +222,15 @@ func (x *MessageResponse) GetMessage() string {
 	return ""
 }
 
+// impression_id and creative are set by GetBanner. Clicks on the banner refer to impression_id.
 type BannerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id           string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ImpressionId string    `protobuf:"bytes,2,opt,name=impression_id,json=impressionId,proto3" json:"impression_id,omitempty"`
+	Creative     *Creative `protobuf:"bytes,3,opt,name=creative,proto3" json:"creative,omitempty"`
 }
 
 func (x *BannerResponse) Reset() {
@@ -115,6 +272,163 @@ func (x *BannerResponse) GetId() string {
 	return ""
 }
 
+func (x *BannerResponse) GetImpressionId() string {
+	if x != nil {
+		return x.ImpressionId
+	}
+	return ""
+}
+
+func (x *BannerResponse) GetCreative() *Creative {
+	if x != nil {
+		return x.Creative
+	}
+	return nil
+}
+
+// Creative describes what is shown for a banner.
+type Creative struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImageUrl   string `protobuf:"bytes,1,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	LandingUrl string `protobuf:"bytes,2,opt,name=landing_url,json=landingUrl,proto3" json:"landing_url,omitempty"`
+	AltText    string `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Width      int32  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height     int32  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	MimeType   string `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+}
+
+func (x *Creative) Reset() {
+	*x = Creative{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Creative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Creative) ProtoMessage() {}
+
+func (x *Creative) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Creative.ProtoReflect.Descriptor instead.
+func (*Creative) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Creative) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Creative) GetLandingUrl() string {
+	if x != nil {
+		return x.LandingUrl
+	}
+	return ""
+}
+
+func (x *Creative) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *Creative) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Creative) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Creative) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type Size struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width  int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *Size) Reset() {
+	*x = Size{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Size) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Size) ProtoMessage() {}
+
+func (x *Size) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Size.ProtoReflect.Descriptor instead.
+func (*Size) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Size) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Size) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 type SlotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -126,7 +440,7 @@ type SlotResponse struct {
 func (x *SlotResponse) Reset() {
 	*x = SlotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[2]
+		mi := &file_api_banner_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -139,7 +453,7 @@ func (x *SlotResponse) String() string {
 func (*SlotResponse) ProtoMessage() {}
 
 func (x *SlotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[2]
+	mi := &file_api_banner_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +466,7 @@ func (x *SlotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SlotResponse.ProtoReflect.Descriptor instead.
 func (*SlotResponse) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{2}
+	return file_api_banner_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SlotResponse) GetId() string {
@@ -173,7 +487,7 @@ type SocialDemoResponse struct {
 func (x *SocialDemoResponse) Reset() {
 	*x = SocialDemoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[3]
+		mi := &file_api_banner_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -186,7 +500,7 @@ func (x *SocialDemoResponse) String() string {
 func (*SocialDemoResponse) ProtoMessage() {}
 
 func (x *SocialDemoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[3]
+	mi := &file_api_banner_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,7 +513,7 @@ func (x *SocialDemoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SocialDemoResponse.ProtoReflect.Descriptor instead.
 func (*SocialDemoResponse) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{3}
+	return file_api_banner_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SocialDemoResponse) GetId() string {
@@ -209,6 +523,7 @@ func (x *SocialDemoResponse) GetId() string {
 	return ""
 }
 
+// A slot accepts banners of its sizes only, or of any size when sizes are empty.
 type SlotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -216,12 +531,19 @@ type SlotRequest struct {
 
 	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// On create, returns the existing entity instead of AlreadyExists
+	// when it has the same description and sizes.
+	IfNotExists bool    `protobuf:"varint,3,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Sizes       []*Size `protobuf:"bytes,4,rep,name=sizes,proto3" json:"sizes,omitempty"`
+	// On update, the fields to set, such as "description" or "sizes". Without it,
+	// only the fields which are set in the request are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
 func (x *SlotRequest) Reset() {
 	*x = SlotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[4]
+		mi := &file_api_banner_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -234,7 +556,7 @@ func (x *SlotRequest) String() string {
 func (*SlotRequest) ProtoMessage() {}
 
 func (x *SlotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[4]
+	mi := &file_api_banner_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +569,7 @@ func (x *SlotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SlotRequest.ProtoReflect.Descriptor instead.
 func (*SlotRequest) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{4}
+	return file_api_banner_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SlotRequest) GetId() string {
@@ -264,6 +586,27 @@ func (x *SlotRequest) GetDescription() string {
 	return ""
 }
 
+func (x *SlotRequest) GetIfNotExists() bool {
+	if x != nil {
+		return x.IfNotExists
+	}
+	return false
+}
+
+func (x *SlotRequest) GetSizes() []*Size {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *SlotRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type BannerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -271,12 +614,20 @@ type BannerRequest struct {
 
 	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// On create, returns the existing entity instead of AlreadyExists
+	// when it has the same description and creative.
+	IfNotExists bool      `protobuf:"varint,3,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Creative    *Creative `protobuf:"bytes,4,opt,name=creative,proto3" json:"creative,omitempty"`
+	// On update, the fields to set, such as "description", "creative" or
+	// "creative.image_url". Without it, only the fields which are set in the
+	// request are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
 func (x *BannerRequest) Reset() {
 	*x = BannerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[5]
+		mi := &file_api_banner_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -289,7 +640,7 @@ func (x *BannerRequest) String() string {
 func (*BannerRequest) ProtoMessage() {}
 
 func (x *BannerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[5]
+	mi := &file_api_banner_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +653,7 @@ func (x *BannerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BannerRequest.ProtoReflect.Descriptor instead.
 func (*BannerRequest) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{5}
+	return file_api_banner_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BannerRequest) GetId() string {
@@ -319,6 +670,27 @@ func (x *BannerRequest) GetDescription() string {
 	return ""
 }
 
+func (x *BannerRequest) GetIfNotExists() bool {
+	if x != nil {
+		return x.IfNotExists
+	}
+	return false
+}
+
+func (x *BannerRequest) GetCreative() *Creative {
+	if x != nil {
+		return x.Creative
+	}
+	return nil
+}
+
+func (x *BannerRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type SocialDemoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -326,12 +698,15 @@ type SocialDemoRequest struct {
 
 	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// On create, returns the existing entity instead of AlreadyExists
+	// when it has the same description.
+	IfNotExists bool `protobuf:"varint,3,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
 }
 
 func (x *SocialDemoRequest) Reset() {
 	*x = SocialDemoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[6]
+		mi := &file_api_banner_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -344,7 +719,7 @@ func (x *SocialDemoRequest) String() string {
 func (*SocialDemoRequest) ProtoMessage() {}
 
 func (x *SocialDemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[6]
+	mi := &file_api_banner_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +732,7 @@ func (x *SocialDemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SocialDemoRequest.ProtoReflect.Descriptor instead.
 func (*SocialDemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{6}
+	return file_api_banner_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SocialDemoRequest) GetId() string {
@@ -374,6 +749,13 @@ func (x *SocialDemoRequest) GetDescription() string {
 	return ""
 }
 
+func (x *SocialDemoRequest) GetIfNotExists() bool {
+	if x != nil {
+		return x.IfNotExists
+	}
+	return false
+}
+
 type AddBannerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -381,12 +763,14 @@ type AddBannerRequest struct {
 
 	BannerId string `protobuf:"bytes,1,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
 	SlotId   string `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// Succeeds instead of AlreadyExists when the banner is already in rotation.
+	IfNotExists bool `protobuf:"varint,3,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
 }
 
 func (x *AddBannerRequest) Reset() {
 	*x = AddBannerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[7]
+		mi := &file_api_banner_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +783,7 @@ func (x *AddBannerRequest) String() string {
 func (*AddBannerRequest) ProtoMessage() {}
 
 func (x *AddBannerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[7]
+	mi := &file_api_banner_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +796,7 @@ func (x *AddBannerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddBannerRequest.ProtoReflect.Descriptor instead.
 func (*AddBannerRequest) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{7}
+	return file_api_banner_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AddBannerRequest) GetBannerId() string {
@@ -429,6 +813,13 @@ func (x *AddBannerRequest) GetSlotId() string {
 	return ""
 }
 
+func (x *AddBannerRequest) GetIfNotExists() bool {
+	if x != nil {
+		return x.IfNotExists
+	}
+	return false
+}
+
 type RemoveBannerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -441,7 +832,7 @@ type RemoveBannerRequest struct {
 func (x *RemoveBannerRequest) Reset() {
 	*x = RemoveBannerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[8]
+		mi := &file_api_banner_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -454,7 +845,7 @@ func (x *RemoveBannerRequest) String() string {
 func (*RemoveBannerRequest) ProtoMessage() {}
 
 func (x *RemoveBannerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[8]
+	mi := &file_api_banner_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +858,7 @@ func (x *RemoveBannerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveBannerRequest.ProtoReflect.Descriptor instead.
 func (*RemoveBannerRequest) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{8}
+	return file_api_banner_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RemoveBannerRequest) GetSlotId() string {
@@ -484,6 +875,10 @@ func (x *RemoveBannerRequest) GetBannerId() string {
 	return ""
 }
 
+// impression_id is required. The click is checked against the impression, which is
+// clicked once at most, and the other ids may be omitted. With the
+// events.clicks_without_impression option, old clients may send the banner, slot and
+// social demo ids instead, and the banner must be in rotation of the slot.
 type ClickEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -492,12 +887,14 @@ type ClickEventRequest struct {
 	SlotId       string `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
 	BannerId     string `protobuf:"bytes,2,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
 	SocialDemoId string `protobuf:"bytes,3,opt,name=social_demo_id,json=socialDemoId,proto3" json:"social_demo_id,omitempty"`
+	ImpressionId string `protobuf:"bytes,4,opt,name=impression_id,json=impressionId,proto3" json:"impression_id,omitempty"`
+	UserId       string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
 func (x *ClickEventRequest) Reset() {
 	*x = ClickEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[9]
+		mi := &file_api_banner_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -510,7 +907,7 @@ func (x *ClickEventRequest) String() string {
 func (*ClickEventRequest) ProtoMessage() {}
 
 func (x *ClickEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[9]
+	mi := &file_api_banner_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +920,7 @@ func (x *ClickEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClickEventRequest.ProtoReflect.Descriptor instead.
 func (*ClickEventRequest) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{9}
+	return file_api_banner_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ClickEventRequest) GetSlotId() string {
@@ -547,19 +944,36 @@ func (x *ClickEventRequest) GetSocialDemoId() string {
 	return ""
 }
 
-type GetBannerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+func (x *ClickEventRequest) GetImpressionId() string {
+	if x != nil {
+		return x.ImpressionId
+	}
+	return ""
+}
+
+func (x *ClickEventRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// user_id is an optional anonymous visitor id. Banners the visitor has reached
+// the frequency cap of are not shown.
+type GetBannerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
 	SlotId       string `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
 	SocialDemoId string `protobuf:"bytes,2,opt,name=social_demo_id,json=socialDemoId,proto3" json:"social_demo_id,omitempty"`
+	UserId       string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
 func (x *GetBannerRequest) Reset() {
 	*x = GetBannerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_banner_proto_msgTypes[10]
+		mi := &file_api_banner_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -572,7 +986,7 @@ func (x *GetBannerRequest) String() string {
 func (*GetBannerRequest) ProtoMessage() {}
 
 func (x *GetBannerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_banner_proto_msgTypes[10]
+	mi := &file_api_banner_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,21 +999,1470 @@ func (x *GetBannerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBannerRequest.ProtoReflect.Descriptor instead.
 func (*GetBannerRequest) Descriptor() ([]byte, []int) {
-	return file_api_banner_proto_rawDescGZIP(), []int{10}
+	return file_api_banner_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetBannerRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *GetBannerRequest) GetSocialDemoId() string {
+	if x != nil {
+		return x.SocialDemoId
+	}
+	return ""
+}
+
+func (x *GetBannerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// With unique, a banner is shown in one slot of the page at most.
+type GetBannersForPageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotIds      []string `protobuf:"bytes,1,rep,name=slot_ids,json=slotIds,proto3" json:"slot_ids,omitempty"`
+	SocialDemoId string   `protobuf:"bytes,2,opt,name=social_demo_id,json=socialDemoId,proto3" json:"social_demo_id,omitempty"`
+	Unique       bool     `protobuf:"varint,3,opt,name=unique,proto3" json:"unique,omitempty"`
+	UserId       string   `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetBannersForPageRequest) Reset() {
+	*x = GetBannersForPageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBannersForPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBannersForPageRequest) ProtoMessage() {}
+
+func (x *GetBannersForPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBannersForPageRequest.ProtoReflect.Descriptor instead.
+func (*GetBannersForPageRequest) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetBannersForPageRequest) GetSlotIds() []string {
+	if x != nil {
+		return x.SlotIds
+	}
+	return nil
+}
+
+func (x *GetBannersForPageRequest) GetSocialDemoId() string {
+	if x != nil {
+		return x.SocialDemoId
+	}
+	return ""
+}
+
+func (x *GetBannersForPageRequest) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *GetBannersForPageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// banner_id is empty when the slot has no banner to show.
+type PageBanner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotId       string    `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	BannerId     string    `protobuf:"bytes,2,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
+	ImpressionId string    `protobuf:"bytes,3,opt,name=impression_id,json=impressionId,proto3" json:"impression_id,omitempty"`
+	Creative     *Creative `protobuf:"bytes,4,opt,name=creative,proto3" json:"creative,omitempty"`
+}
+
+func (x *PageBanner) Reset() {
+	*x = PageBanner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageBanner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageBanner) ProtoMessage() {}
+
+func (x *PageBanner) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageBanner.ProtoReflect.Descriptor instead.
+func (*PageBanner) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PageBanner) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *PageBanner) GetBannerId() string {
+	if x != nil {
+		return x.BannerId
+	}
+	return ""
+}
+
+func (x *PageBanner) GetImpressionId() string {
+	if x != nil {
+		return x.ImpressionId
+	}
+	return ""
+}
+
+func (x *PageBanner) GetCreative() *Creative {
+	if x != nil {
+		return x.Creative
+	}
+	return nil
+}
+
+type GetBannersForPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Banners []*PageBanner `protobuf:"bytes,1,rep,name=banners,proto3" json:"banners,omitempty"`
+}
+
+func (x *GetBannersForPageResponse) Reset() {
+	*x = GetBannersForPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBannersForPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBannersForPageResponse) ProtoMessage() {}
+
+func (x *GetBannersForPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBannersForPageResponse.ProtoReflect.Descriptor instead.
+func (*GetBannersForPageResponse) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBannersForPageResponse) GetBanners() []*PageBanner {
+	if x != nil {
+		return x.Banners
+	}
+	return nil
+}
+
+type Banner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Creative    *Creative              `protobuf:"bytes,4,opt,name=creative,proto3" json:"creative,omitempty"`
+}
+
+func (x *Banner) Reset() {
+	*x = Banner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Banner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Banner) ProtoMessage() {}
+
+func (x *Banner) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Banner.ProtoReflect.Descriptor instead.
+func (*Banner) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Banner) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Banner) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Banner) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Banner) GetCreative() *Creative {
+	if x != nil {
+		return x.Creative
+	}
+	return nil
+}
+
+type Slot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Sizes       []*Size                `protobuf:"bytes,4,rep,name=sizes,proto3" json:"sizes,omitempty"`
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Slot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Slot) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Slot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Slot) GetSizes() []*Size {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+type SocialDemo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SocialDemo) Reset() {
+	*x = SocialDemo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SocialDemo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialDemo) ProtoMessage() {}
+
+func (x *SocialDemo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialDemo.ProtoReflect.Descriptor instead.
+func (*SocialDemo) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SocialDemo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SocialDemo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SocialDemo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRequest) ProtoMessage() {}
+
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// A rotation entry is active from starts_at until ends_at, when they are set.
+// They are wall-clock times of time_zone, written as UTC.
+type Rotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotId     string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	BannerId   string                 `protobuf:"bytes,2,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartsAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	TimeZone   string                 `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	CapPerHour int32                  `protobuf:"varint,7,opt,name=cap_per_hour,json=capPerHour,proto3" json:"cap_per_hour,omitempty"`
+	CapPerDay  int32                  `protobuf:"varint,8,opt,name=cap_per_day,json=capPerDay,proto3" json:"cap_per_day,omitempty"`
+}
+
+func (x *Rotation) Reset() {
+	*x = Rotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rotation) ProtoMessage() {}
+
+func (x *Rotation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rotation.ProtoReflect.Descriptor instead.
+func (*Rotation) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Rotation) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *Rotation) GetBannerId() string {
+	if x != nil {
+		return x.BannerId
+	}
+	return ""
+}
+
+func (x *Rotation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rotation) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Rotation) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Rotation) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Rotation) GetCapPerHour() int32 {
+	if x != nil {
+		return x.CapPerHour
+	}
+	return 0
+}
+
+func (x *Rotation) GetCapPerDay() int32 {
+	if x != nil {
+		return x.CapPerDay
+	}
+	return 0
+}
+
+// Restricts a banner in rotation of a slot to social demo groups. The banner is
+// shown to the included groups only, or to any group when include is empty,
+// except the excluded ones. Setting the targeting replaces it.
+type Targeting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BannerId             string   `protobuf:"bytes,1,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
+	SlotId               string   `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	IncludeSocialDemoIds []string `protobuf:"bytes,3,rep,name=include_social_demo_ids,json=includeSocialDemoIds,proto3" json:"include_social_demo_ids,omitempty"`
+	ExcludeSocialDemoIds []string `protobuf:"bytes,4,rep,name=exclude_social_demo_ids,json=excludeSocialDemoIds,proto3" json:"exclude_social_demo_ids,omitempty"`
+}
+
+func (x *Targeting) Reset() {
+	*x = Targeting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Targeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Targeting) ProtoMessage() {}
+
+func (x *Targeting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Targeting.ProtoReflect.Descriptor instead.
+func (*Targeting) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Targeting) GetBannerId() string {
+	if x != nil {
+		return x.BannerId
+	}
+	return ""
+}
+
+func (x *Targeting) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *Targeting) GetIncludeSocialDemoIds() []string {
+	if x != nil {
+		return x.IncludeSocialDemoIds
+	}
+	return nil
+}
+
+func (x *Targeting) GetExcludeSocialDemoIds() []string {
+	if x != nil {
+		return x.ExcludeSocialDemoIds
+	}
+	return nil
+}
+
+type RotationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BannerId string `protobuf:"bytes,1,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
+	SlotId   string `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+}
+
+func (x *RotationRequest) Reset() {
+	*x = RotationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationRequest) ProtoMessage() {}
+
+func (x *RotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationRequest.ProtoReflect.Descriptor instead.
+func (*RotationRequest) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RotationRequest) GetBannerId() string {
+	if x != nil {
+		return x.BannerId
+	}
+	return ""
+}
+
+func (x *RotationRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+// Limits how many times a visitor is shown the banner in the slot in the last hour
+// and the last 24 hours. Views in other slots do not count. Zero means no limit.
+type FrequencyCapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BannerId string `protobuf:"bytes,1,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
+	SlotId   string `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	PerHour  int32  `protobuf:"varint,3,opt,name=per_hour,json=perHour,proto3" json:"per_hour,omitempty"`
+	PerDay   int32  `protobuf:"varint,4,opt,name=per_day,json=perDay,proto3" json:"per_day,omitempty"`
+}
+
+func (x *FrequencyCapRequest) Reset() {
+	*x = FrequencyCapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrequencyCapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequencyCapRequest) ProtoMessage() {}
+
+func (x *FrequencyCapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequencyCapRequest.ProtoReflect.Descriptor instead.
+func (*FrequencyCapRequest) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FrequencyCapRequest) GetBannerId() string {
+	if x != nil {
+		return x.BannerId
+	}
+	return ""
+}
+
+func (x *FrequencyCapRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *FrequencyCapRequest) GetPerHour() int32 {
+	if x != nil {
+		return x.PerHour
+	}
+	return 0
+}
+
+func (x *FrequencyCapRequest) GetPerDay() int32 {
+	if x != nil {
+		return x.PerDay
+	}
+	return 0
+}
+
+// Adds a banner to the slot from starts_at until ends_at, either of which may be
+// omitted. For a banner already in rotation, the schedule is replaced, so ends_at
+// schedules its removal. time_zone is the IANA zone of the campaign, UTC by default.
+// starts_at and ends_at are wall-clock times of time_zone, written as UTC, so
+// 2024-03-01T00:00:00Z in Europe/Moscow is midnight in Moscow.
+type ScheduleRotationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BannerId string                 `protobuf:"bytes,1,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
+	SlotId   string                 `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	TimeZone string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *ScheduleRotationRequest) Reset() {
+	*x = ScheduleRotationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRotationRequest) ProtoMessage() {}
+
+func (x *ScheduleRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRotationRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleRotationRequest) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScheduleRotationRequest) GetBannerId() string {
+	if x != nil {
+		return x.BannerId
+	}
+	return ""
+}
+
+func (x *ScheduleRotationRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *ScheduleRotationRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ScheduleRotationRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *ScheduleRotationRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// Lists are paginated with keyset page tokens. A page token is valid only with
+// the filters and sort order of the request which returned it.
+// description filters banners, slots and social demos by substring,
+// slot_id filters banners and rotations, status filters banners and slots.
+type ListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	SlotId        string                 `protobuf:"bytes,4,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Status        Status                 `protobuf:"varint,7,opt,name=status,proto3,enum=banner.Status" json:"status,omitempty"`
+	OrderBy       SortOrder              `protobuf:"varint,8,opt,name=order_by,json=orderBy,proto3,enum=banner.SortOrder" json:"order_by,omitempty"`
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *ListRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListRequest) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *ListRequest) GetOrderBy() SortOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return SortOrder_SORT_ORDER_ID
+}
+
+// Entities which are in rotation are deleted only with force,
+// which removes them from rotation too. Events are kept for statistics.
+type DeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type BannersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Banners       []*Banner `protobuf:"bytes,1,rep,name=banners,proto3" json:"banners,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *BannersResponse) Reset() {
+	*x = BannersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BannersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BannersResponse) ProtoMessage() {}
+
+func (x *BannersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BannersResponse.ProtoReflect.Descriptor instead.
+func (*BannersResponse) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BannersResponse) GetBanners() []*Banner {
+	if x != nil {
+		return x.Banners
+	}
+	return nil
+}
+
+func (x *BannersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SlotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slots         []*Slot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SlotsResponse) Reset() {
+	*x = SlotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotsResponse) ProtoMessage() {}
+
+func (x *SlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotsResponse.ProtoReflect.Descriptor instead.
+func (*SlotsResponse) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SlotsResponse) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *SlotsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RotationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rotations     []*Rotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *RotationsResponse) Reset() {
+	*x = RotationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationsResponse) ProtoMessage() {}
+
+func (x *RotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationsResponse.ProtoReflect.Descriptor instead.
+func (*RotationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RotationsResponse) GetRotations() []*Rotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+func (x *RotationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SocialDemosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SocialDemos   []*SocialDemo `protobuf:"bytes,1,rep,name=social_demos,json=socialDemos,proto3" json:"social_demos,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SocialDemosResponse) Reset() {
+	*x = SocialDemosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SocialDemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialDemosResponse) ProtoMessage() {}
+
+func (x *SocialDemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialDemosResponse.ProtoReflect.Descriptor instead.
+func (*SocialDemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SocialDemosResponse) GetSocialDemos() []*SocialDemo {
+	if x != nil {
+		return x.SocialDemos
+	}
+	return nil
+}
+
+func (x *SocialDemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Events rolled up by the retention job have no time, so they are counted
+// only without time range and grouping.
+type StatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotId       string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	SocialDemoId string                 `protobuf:"bytes,2,opt,name=social_demo_id,json=socialDemoId,proto3" json:"social_demo_id,omitempty"`
+	From         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	GroupBy      StatsGrouping          `protobuf:"varint,5,opt,name=group_by,json=groupBy,proto3,enum=banner.StatsGrouping" json:"group_by,omitempty"`
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StatsRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *StatsRequest) GetSocialDemoId() string {
+	if x != nil {
+		return x.SocialDemoId
+	}
+	return ""
+}
+
+func (x *StatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *StatsRequest) GetGroupBy() StatsGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return StatsGrouping_STATS_GROUPING_NONE
+}
+
+// ctr_lower and ctr_upper are the bounds of the 95% Wilson score interval of ctr.
+type BannerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BannerId    string                 `protobuf:"bytes,1,opt,name=banner_id,json=bannerId,proto3" json:"banner_id,omitempty"`
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Views       int64                  `protobuf:"varint,3,opt,name=views,proto3" json:"views,omitempty"`
+	Clicks      int64                  `protobuf:"varint,4,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	Ctr         float64                `protobuf:"fixed64,5,opt,name=ctr,proto3" json:"ctr,omitempty"`
+	CtrLower    float64                `protobuf:"fixed64,6,opt,name=ctr_lower,json=ctrLower,proto3" json:"ctr_lower,omitempty"`
+	CtrUpper    float64                `protobuf:"fixed64,7,opt,name=ctr_upper,json=ctrUpper,proto3" json:"ctr_upper,omitempty"`
+}
+
+func (x *BannerStats) Reset() {
+	*x = BannerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BannerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BannerStats) ProtoMessage() {}
+
+func (x *BannerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BannerStats.ProtoReflect.Descriptor instead.
+func (*BannerStats) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BannerStats) GetBannerId() string {
+	if x != nil {
+		return x.BannerId
+	}
+	return ""
+}
+
+func (x *BannerStats) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *BannerStats) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *BannerStats) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *BannerStats) GetCtr() float64 {
+	if x != nil {
+		return x.Ctr
+	}
+	return 0
+}
+
+func (x *BannerStats) GetCtrLower() float64 {
+	if x != nil {
+		return x.CtrLower
+	}
+	return 0
+}
+
+func (x *BannerStats) GetCtrUpper() float64 {
+	if x != nil {
+		return x.CtrUpper
+	}
+	return 0
+}
+
+type StatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*BannerStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_banner_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_banner_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetBannerRequest) GetSlotId() string {
-	if x != nil {
-		return x.SlotId
-	}
-	return ""
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_banner_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetBannerRequest) GetSocialDemoId() string {
+func (x *StatsResponse) GetStats() []*BannerStats {
 	if x != nil {
-		return x.SocialDemoId
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
 var File_api_banner_proto protoreflect.FileDescriptor
@@ -609,98 +2472,761 @@ var file_api_banner_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x28, 0x74, 0x68, 0x69, 0x72,
 	0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x20, 0x0a, 0x0e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x0c, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x6c, 0x6f,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x0d, 0x42, 0x61,
-	0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a,
-	0x11, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x42, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x4b,
-	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x11, 0x43,
-	0x6c, 0x69, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61,
-	0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
-	0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x49, 0x64, 0x32,
-	0xde, 0x05, 0x0a, 0x0f, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x12, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x61, 0x64, 0x64,
-	0x3a, 0x01, 0x2a, 0x12, 0x67, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1b, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65,
-	0x72, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x62, 0x0a, 0x0a,
-	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x62, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x3a, 0x01, 0x2a,
-	0x12, 0x5d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x2e,
-	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12,
-	0x66, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12,
-	0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x5e, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3a, 0x74,
+	0x68, 0x69, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x0f, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x73, 0x0a, 0x0e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a,
+	0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x90, 0x02, 0x0a, 0x08,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xc2, 0xf3, 0x18,
+	0x0f, 0x10, 0x80, 0x10, 0x1a, 0x0a, 0x5e, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3f, 0x3a, 0x2f, 0x2f,
+	0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x34, 0x0a, 0x0b, 0x6c, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x13, 0xc2, 0xf3, 0x18, 0x0f, 0x10, 0x80, 0x10, 0x1a, 0x0a, 0x5e, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x3f, 0x3a, 0x2f, 0x2f, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c,
+	0x12, 0x22, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x10, 0x80, 0x02, 0x52, 0x07, 0x61, 0x6c, 0x74,
+	0x54, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x1e, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc2, 0xf3, 0x18, 0x19, 0x1a, 0x15, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x5d, 0x2b, 0x2f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x2b, 0x2d, 0x5d,
+	0x2b, 0x24, 0x10, 0x64, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x44,
+	0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x1e, 0x0a, 0x0c, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65,
+	0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x97, 0x02, 0x0a, 0x0b, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3, 0x18, 0x16, 0x1a, 0x12, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24,
+	0x10, 0x40, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xc2, 0xf3, 0x18,
+	0x29, 0x10, 0x80, 0x08, 0x1a, 0x24, 0x5e, 0x5b, 0x5e, 0x5c, 0x78, 0x30, 0x30, 0x2d, 0x5c, 0x78,
+	0x30, 0x38, 0x5c, 0x78, 0x30, 0x62, 0x5c, 0x78, 0x30, 0x63, 0x5c, 0x78, 0x30, 0x65, 0x2d, 0x5c,
+	0x78, 0x31, 0x66, 0x5c, 0x78, 0x37, 0x66, 0x5d, 0x2a, 0x24, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x73,
+	0x69, 0x7a, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x64,
+	0x52, 0x05, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x9b, 0x02, 0x0a, 0x0d, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3, 0x18, 0x16, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xc2, 0xf3, 0x18, 0x29, 0x1a, 0x24, 0x5e,
+	0x5b, 0x5e, 0x5c, 0x78, 0x30, 0x30, 0x2d, 0x5c, 0x78, 0x30, 0x38, 0x5c, 0x78, 0x30, 0x62, 0x5c,
+	0x78, 0x30, 0x63, 0x5c, 0x78, 0x30, 0x65, 0x2d, 0x5c, 0x78, 0x31, 0x66, 0x5c, 0x78, 0x37, 0x66,
+	0x5d, 0x2a, 0x24, 0x10, 0x80, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f,
+	0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x08, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3, 0x18, 0x16, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xc2, 0xf3, 0x18, 0x29, 0x10,
+	0x80, 0x08, 0x1a, 0x24, 0x5e, 0x5b, 0x5e, 0x5c, 0x78, 0x30, 0x30, 0x2d, 0x5c, 0x78, 0x30, 0x38,
+	0x5c, 0x78, 0x30, 0x62, 0x5c, 0x78, 0x30, 0x63, 0x5c, 0x78, 0x30, 0x65, 0x2d, 0x5c, 0x78, 0x31,
+	0x66, 0x5c, 0x78, 0x37, 0x66, 0x5d, 0x2a, 0x24, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66,
+	0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x10, 0x41, 0x64,
+	0x64, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
+	0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18, 0x08, 0x01, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52,
+	0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6c, 0x6f,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18,
+	0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a,
+	0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x10, 0x40, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07,
+	0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2,
+	0xf3, 0x18, 0x18, 0x08, 0x01, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x06, 0x73, 0x6c, 0x6f,
+	0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18, 0x1a, 0x12, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24,
+	0x08, 0x01, 0x10, 0x40, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa6,
+	0x02, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3, 0x18, 0x16, 0x1a, 0x12, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x10,
+	0x40, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3,
+	0x18, 0x16, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6d,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3, 0x18, 0x16,
+	0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a,
+	0x2d, 0x5d, 0x2b, 0x24, 0x10, 0x40, 0x52, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65,
+	0x6d, 0x6f, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3, 0x18,
+	0x16, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x10, 0x80, 0x01, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07,
+	0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2,
+	0xf3, 0x18, 0x18, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30,
+	0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x52, 0x06, 0x73, 0x6c, 0x6f,
+	0x74, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0e, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65,
+	0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18,
+	0x18, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x52, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x10, 0x80,
+	0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd7, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22, 0xc2, 0xf3, 0x18, 0x1e, 0x08, 0x01,
+	0x10, 0x64, 0x2a, 0x18, 0x08, 0x01, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x07, 0x73, 0x6c,
+	0x6f, 0x74, 0x49, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f,
+	0x64, 0x65, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2,
+	0xf3, 0x18, 0x18, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x10, 0x40, 0x52, 0x0c, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x10, 0x80, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x65, 0x42, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a,
+	0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x49, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x07, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x06, 0x42, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c,
+	0x0a, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x97, 0x01, 0x0a,
+	0x04, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52,
+	0x05, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x0a, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x44, 0x65, 0x6d, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3,
+	0x18, 0x18, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc8,
+	0x02, 0x0a, 0x08, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c,
+	0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63,
+	0x61, 0x70, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x61, 0x70,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x63, 0x61, 0x70, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x22, 0xaf, 0x02, 0x0a, 0x09, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18,
+	0x08, 0x01, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18, 0x08, 0x01, 0x10, 0x40, 0x1a, 0x12, 0x5e,
+	0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x17, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6d, 0x6f,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x20, 0xc2, 0xf3, 0x18, 0x1c,
+	0x10, 0x64, 0x2a, 0x18, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x10, 0x40, 0x52, 0x14, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x49,
+	0x64, 0x73, 0x12, 0x57, 0x0a, 0x17, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x20, 0xc2, 0xf3, 0x18, 0x1c, 0x10, 0x64, 0x2a, 0x18, 0x10, 0x40, 0x1a,
+	0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d,
+	0x5d, 0x2b, 0x24, 0x08, 0x01, 0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x49, 0x64, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0f,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18, 0x08, 0x01, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24,
+	0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6c,
+	0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18,
+	0x18, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e,
+	0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x10, 0x40, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49,
+	0x64, 0x22, 0xcb, 0x01, 0x0a, 0x13, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3,
+	0x18, 0x18, 0x08, 0x01, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18, 0x08, 0x01, 0x10, 0x40, 0x1a,
+	0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d,
+	0x5d, 0x2b, 0x24, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x70,
+	0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xc2,
+	0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x07, 0x70, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x1f,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x70, 0x65, 0x72, 0x44, 0x61, 0x79, 0x22,
+	0x9e, 0x02, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0xc2, 0xf3, 0x18, 0x18, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18, 0x1a, 0x12, 0x5e,
+	0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b,
+	0x24, 0x08, 0x01, 0x10, 0x40, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xc2, 0xf3, 0x18, 0x02, 0x10, 0x40, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x22, 0x94, 0x03, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x10,
+	0x80, 0x08, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xc2, 0xf3, 0x18, 0x03, 0x10, 0x80, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3, 0x18, 0x16, 0x10,
+	0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e,
+	0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x41,
+	0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x53, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xc2, 0xf3, 0x18, 0x18, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24,
+	0x08, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x63, 0x0a, 0x0f,
+	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x07, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x52, 0x07, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x5b, 0x0a, 0x0d, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b,
+	0x0a, 0x11, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x13, 0x53,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6d,
+	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x52, 0x0b, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x93, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1a, 0xc2, 0xf3, 0x18, 0x16, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52,
+	0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1a, 0xc2, 0xf3, 0x18, 0x16, 0x10, 0x40, 0x1a, 0x12, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2e, 0x3a, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0c, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x30, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0xe3, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69,
+	0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x74, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x63, 0x74, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x74, 0x72, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x74, 0x72, 0x4c, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x74, 0x72, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x74, 0x72, 0x55, 0x70, 0x70, 0x65, 0x72, 0x22, 0x3a, 0x0a,
+	0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2a, 0x48, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56,
+	0x45, 0x10, 0x02, 0x2a, 0x71, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49,
+	0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x49, 0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x44, 0x5f, 0x41, 0x54, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f,
+	0x44, 0x45, 0x53, 0x43, 0x10, 0x03, 0x2a, 0x59, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x49,
+	0x4e, 0x47, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x41, 0x59, 0x10,
+	0x02, 0x32, 0x8c, 0x28, 0x0a, 0x0f, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x95, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x42, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
+	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01,
+	0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x2f, 0x61, 0x64, 0x64, 0x92, 0x41, 0x34, 0x12, 0x27, 0x41, 0x64, 0x64, 0x73, 0x20,
+	0x61, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x73, 0x6c,
+	0x6f, 0x74, 0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xee, 0x01,
+	0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x1b,
+	0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a,
+	0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x73, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x92, 0x41, 0x82, 0x01, 0x4a, 0x47, 0x0a, 0x03,
+	0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x16, 0x0a,
+	0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x20, 0x61,
+	0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x73,
+	0x6c, 0x6f, 0x74, 0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xdf,
+	0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x9c, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x63,
+	0x6c, 0x69, 0x63, 0x6b, 0x92, 0x41, 0x75, 0x12, 0x21, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x20, 0x61, 0x20, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x20, 0x6f, 0x6e, 0x20, 0x61, 0x20, 0x73, 0x68,
+	0x6f, 0x77, 0x6e, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x73, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26, 0x41, 0x6e,
+	0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0xc8, 0xf3, 0x18, 0x01,
+	0x12, 0xea, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x18,
+	0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xaa, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x65,
+	0x74, 0x92, 0x41, 0x84, 0x01, 0x12, 0x30, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x20, 0x61,
+	0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x73, 0x6c,
+	0x6f, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x69,
+	0x74, 0x73, 0x20, 0x76, 0x69, 0x65, 0x77, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73,
+	0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0xc8, 0xf3, 0x18, 0x01, 0x12, 0xca, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x73, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x92, 0x41, 0x4a, 0x0a, 0x07, 0x42, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x20, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72,
+	0x20, 0x76, 0x69, 0x65, 0x77, 0x73, 0xc8, 0xf3, 0x18, 0x01, 0x12, 0x8a, 0x01, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x92, 0x41, 0x21, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61,
+	0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x7e, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53,
 	0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x61, 0x6e,
 	0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x2f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x77, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x12, 0x19, 0x2e, 0x62, 0x61,
-	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
-	0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a,
-	0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x92, 0x41, 0x1d, 0x12, 0x0e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x73, 0x6c, 0x6f, 0x74, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x20, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x12, 0x19, 0x2e, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x60, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x92, 0x41, 0x31, 0x12, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x20, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20,
+	0x64, 0x65, 0x6d, 0x6f, 0x73, 0x12, 0xc3, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x42, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x8f, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41,
+	0x6a, 0x12, 0x10, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x12, 0x16, 0x0a, 0x14, 0x1a,
+	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x84, 0x01, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x92, 0x41, 0x27, 0x12, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x73, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x20, 0x62, 0x79, 0x20, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0xca, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x92, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x1a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x92, 0x41, 0x6a, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x73, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x12, 0x16, 0x0a,
+	0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x10, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12,
+	0xd0, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x12, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x8f, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x6a, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40,
+	0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x12, 0xb9, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12,
+	0x13, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x22, 0x89, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x66, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26,
+	0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x7a,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
+	0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x92, 0x41, 0x23, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x12, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x20, 0x62, 0x79, 0x20, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0xbe, 0x01, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
+	0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x8c, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x92, 0x41, 0x66, 0x12, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61,
+	0x20, 0x73, 0x6c, 0x6f, 0x74, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x12, 0x16, 0x0a, 0x14, 0x1a,
+	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0xc8, 0x01, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x15, 0x2e, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x66,
+	0x12, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x73, 0x6c, 0x6f, 0x74,
+	0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x4a, 0x47, 0x0a,
+	0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x16,
+	0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xe0, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65,
+	0x6d, 0x6f, 0x22, 0xa4, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x7a, 0x12,
+	0x1b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x0a, 0x12, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x20, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x73,
+	0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x73, 0x12, 0x13, 0x2e,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x2d, 0x64, 0x65,
+	0x6d, 0x6f, 0x73, 0x92, 0x41, 0x37, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x73, 0x12, 0x21, 0x4c, 0x69, 0x73, 0x74,
+	0x73, 0x20, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x20, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x20, 0x62, 0x79, 0x20, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0xeb, 0x01,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65,
+	0x6d, 0x6f, 0x12, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d,
+	0x6f, 0x22, 0xa7, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x1a, 0x1f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41,
+	0x7a, 0x12, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x0a, 0x12,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d,
+	0x6f, 0x73, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26, 0x41, 0x6e, 0x20,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xe9, 0x01, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6d, 0x6f,
+	0x12, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xa4, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x2d,
+	0x64, 0x65, 0x6d, 0x6f, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x7a, 0x0a, 0x12, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x20, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f,
+	0x73, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d,
+	0x6f, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x8f, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x2c, 0x0a, 0x09, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x20,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x20, 0x62, 0x79, 0x20, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0xf8, 0x01, 0x0a, 0x10, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x92, 0x41, 0x7b, 0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34,
+	0x12, 0x40, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x2e, 0x12, 0x25, 0x53, 0x65, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xff, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb5,
+	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x2d, 0x63, 0x61,
+	0x70, 0x92, 0x41, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x4a, 0x47, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x53, 0x65, 0x74, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x63, 0x61,
+	0x70, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0xfe, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x22, 0xc1, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x92,
+	0x41, 0x94, 0x01, 0x12, 0x3e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x20, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x20, 0x61, 0x20, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x20, 0x69, 0x73, 0x20, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x65, 0x64,
+	0x20, 0x61, 0x74, 0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x47,
+	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x40, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x0a,
+	0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0xeb, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x11, 0x2e, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xb4,
+	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x1a, 0x21, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x92, 0x41, 0x84,
+	0x01, 0x12, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x61, 0x74, 0x20, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x47, 0x0a, 0x03,
+	0x34, 0x30, 0x34, 0x12, 0x40, 0x0a, 0x26, 0x41, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x16, 0x0a,
+	0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x92, 0x41, 0x31, 0x12,
+	0x28, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2c, 0x20,
+	0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x43, 0x54, 0x52, 0x20, 0x6f,
+	0x66, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x42, 0x9b, 0x05, 0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x92, 0x41, 0x90, 0x05, 0x12, 0x7a,
+	0x0a, 0x10, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x20, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x12, 0x61, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73,
+	0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x73, 0x69, 0x74, 0x65, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x61, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x61, 0x72, 0x6d, 0x65, 0x64, 0x20,
+	0x62, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x76, 0x69, 0x65, 0x77, 0x73, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x2e, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5a, 0x56, 0x0a, 0x19, 0x0a, 0x06, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x0f,
+	0x08, 0x02, 0x20, 0x02, 0x1a, 0x09, 0x58, 0x2d, 0x41, 0x70, 0x69, 0x2d, 0x4b, 0x65, 0x79, 0x0a,
+	0x39, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x2f, 0x12, 0x1a, 0x41, 0x20, 0x4a,
+	0x57, 0x54, 0x20, 0x61, 0x73, 0x20, 0x60, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x3c, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x3e, 0x60, 0x2e, 0x08, 0x02, 0x20, 0x02, 0x1a, 0x0d, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06,
+	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x00, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x52, 0x67, 0x0a, 0x03, 0x34, 0x32, 0x39, 0x12, 0x60, 0x0a,
+	0x46, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6f,
+	0x76, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x61, 0x74, 0x65, 0x20, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x3b, 0x20, 0x72, 0x65, 0x74, 0x72, 0x79, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79, 0x2d, 0x41, 0x66, 0x74, 0x65, 0x72, 0x20,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x74, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x6d, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x0a, 0x53, 0x54, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x2e, 0x52, 0x44, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x3d, 0x0a, 0x23,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x2e, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x51, 0x0a, 0x03, 0x34,
+	0x30, 0x33, 0x12, 0x4a, 0x12, 0x16, 0x0a, 0x14, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x0a, 0x30, 0x54, 0x68,
+	0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -715,40 +3241,137 @@ func file_api_banner_proto_rawDescGZIP() []byte {
 	return file_api_banner_proto_rawDescData
 }
 
-var file_api_banner_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_banner_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_banner_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_api_banner_proto_goTypes = []interface{}{
-	(*MessageResponse)(nil),     // 0: banner.MessageResponse
-	(*BannerResponse)(nil),      // 1: banner.BannerResponse
-	(*SlotResponse)(nil),        // 2: banner.SlotResponse
-	(*SocialDemoResponse)(nil),  // 3: banner.SocialDemoResponse
-	(*SlotRequest)(nil),         // 4: banner.SlotRequest
-	(*BannerRequest)(nil),       // 5: banner.BannerRequest
-	(*SocialDemoRequest)(nil),   // 6: banner.SocialDemoRequest
-	(*AddBannerRequest)(nil),    // 7: banner.AddBannerRequest
-	(*RemoveBannerRequest)(nil), // 8: banner.RemoveBannerRequest
-	(*ClickEventRequest)(nil),   // 9: banner.ClickEventRequest
-	(*GetBannerRequest)(nil),    // 10: banner.GetBannerRequest
+	(Status)(0),                       // 0: banner.Status
+	(SortOrder)(0),                    // 1: banner.SortOrder
+	(StatsGrouping)(0),                // 2: banner.StatsGrouping
+	(*MessageResponse)(nil),           // 3: banner.MessageResponse
+	(*BannerResponse)(nil),            // 4: banner.BannerResponse
+	(*Creative)(nil),                  // 5: banner.Creative
+	(*Size)(nil),                      // 6: banner.Size
+	(*SlotResponse)(nil),              // 7: banner.SlotResponse
+	(*SocialDemoResponse)(nil),        // 8: banner.SocialDemoResponse
+	(*SlotRequest)(nil),               // 9: banner.SlotRequest
+	(*BannerRequest)(nil),             // 10: banner.BannerRequest
+	(*SocialDemoRequest)(nil),         // 11: banner.SocialDemoRequest
+	(*AddBannerRequest)(nil),          // 12: banner.AddBannerRequest
+	(*RemoveBannerRequest)(nil),       // 13: banner.RemoveBannerRequest
+	(*ClickEventRequest)(nil),         // 14: banner.ClickEventRequest
+	(*GetBannerRequest)(nil),          // 15: banner.GetBannerRequest
+	(*GetBannersForPageRequest)(nil),  // 16: banner.GetBannersForPageRequest
+	(*PageBanner)(nil),                // 17: banner.PageBanner
+	(*GetBannersForPageResponse)(nil), // 18: banner.GetBannersForPageResponse
+	(*Banner)(nil),                    // 19: banner.Banner
+	(*Slot)(nil),                      // 20: banner.Slot
+	(*SocialDemo)(nil),                // 21: banner.SocialDemo
+	(*ReadRequest)(nil),               // 22: banner.ReadRequest
+	(*Rotation)(nil),                  // 23: banner.Rotation
+	(*Targeting)(nil),                 // 24: banner.Targeting
+	(*RotationRequest)(nil),           // 25: banner.RotationRequest
+	(*FrequencyCapRequest)(nil),       // 26: banner.FrequencyCapRequest
+	(*ScheduleRotationRequest)(nil),   // 27: banner.ScheduleRotationRequest
+	(*ListRequest)(nil),               // 28: banner.ListRequest
+	(*DeleteRequest)(nil),             // 29: banner.DeleteRequest
+	(*BannersResponse)(nil),           // 30: banner.BannersResponse
+	(*SlotsResponse)(nil),             // 31: banner.SlotsResponse
+	(*RotationsResponse)(nil),         // 32: banner.RotationsResponse
+	(*SocialDemosResponse)(nil),       // 33: banner.SocialDemosResponse
+	(*StatsRequest)(nil),              // 34: banner.StatsRequest
+	(*BannerStats)(nil),               // 35: banner.BannerStats
+	(*StatsResponse)(nil),             // 36: banner.StatsResponse
+	(*fieldmaskpb.FieldMask)(nil),     // 37: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),     // 38: google.protobuf.Timestamp
 }
 var file_api_banner_proto_depIdxs = []int32{
-	7,  // 0: banner.BannersRotation.AddBanner:input_type -> banner.AddBannerRequest
-	8,  // 1: banner.BannersRotation.RemoveBanner:input_type -> banner.RemoveBannerRequest
-	9,  // 2: banner.BannersRotation.ClickEvent:input_type -> banner.ClickEventRequest
-	10, // 3: banner.BannersRotation.GetBanner:input_type -> banner.GetBannerRequest
-	5,  // 4: banner.BannersRotation.CreateBanner:input_type -> banner.BannerRequest
-	4,  // 5: banner.BannersRotation.CreateSlot:input_type -> banner.SlotRequest
-	6,  // 6: banner.BannersRotation.CreateSocialDemo:input_type -> banner.SocialDemoRequest
-	0,  // 7: banner.BannersRotation.AddBanner:output_type -> banner.MessageResponse
-	0,  // 8: banner.BannersRotation.RemoveBanner:output_type -> banner.MessageResponse
-	0,  // 9: banner.BannersRotation.ClickEvent:output_type -> banner.MessageResponse
-	1,  // 10: banner.BannersRotation.GetBanner:output_type -> banner.BannerResponse
-	1,  // 11: banner.BannersRotation.CreateBanner:output_type -> banner.BannerResponse
-	2,  // 12: banner.BannersRotation.CreateSlot:output_type -> banner.SlotResponse
-	3,  // 13: banner.BannersRotation.CreateSocialDemo:output_type -> banner.SocialDemoResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	5,  // 0: banner.BannerResponse.creative:type_name -> banner.Creative
+	6,  // 1: banner.SlotRequest.sizes:type_name -> banner.Size
+	37, // 2: banner.SlotRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 3: banner.BannerRequest.creative:type_name -> banner.Creative
+	37, // 4: banner.BannerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 5: banner.PageBanner.creative:type_name -> banner.Creative
+	17, // 6: banner.GetBannersForPageResponse.banners:type_name -> banner.PageBanner
+	38, // 7: banner.Banner.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: banner.Banner.creative:type_name -> banner.Creative
+	38, // 9: banner.Slot.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 10: banner.Slot.sizes:type_name -> banner.Size
+	38, // 11: banner.SocialDemo.created_at:type_name -> google.protobuf.Timestamp
+	38, // 12: banner.Rotation.created_at:type_name -> google.protobuf.Timestamp
+	38, // 13: banner.Rotation.starts_at:type_name -> google.protobuf.Timestamp
+	38, // 14: banner.Rotation.ends_at:type_name -> google.protobuf.Timestamp
+	38, // 15: banner.ScheduleRotationRequest.starts_at:type_name -> google.protobuf.Timestamp
+	38, // 16: banner.ScheduleRotationRequest.ends_at:type_name -> google.protobuf.Timestamp
+	38, // 17: banner.ListRequest.created_after:type_name -> google.protobuf.Timestamp
+	38, // 18: banner.ListRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,  // 19: banner.ListRequest.status:type_name -> banner.Status
+	1,  // 20: banner.ListRequest.order_by:type_name -> banner.SortOrder
+	19, // 21: banner.BannersResponse.banners:type_name -> banner.Banner
+	20, // 22: banner.SlotsResponse.slots:type_name -> banner.Slot
+	23, // 23: banner.RotationsResponse.rotations:type_name -> banner.Rotation
+	21, // 24: banner.SocialDemosResponse.social_demos:type_name -> banner.SocialDemo
+	38, // 25: banner.StatsRequest.from:type_name -> google.protobuf.Timestamp
+	38, // 26: banner.StatsRequest.to:type_name -> google.protobuf.Timestamp
+	2,  // 27: banner.StatsRequest.group_by:type_name -> banner.StatsGrouping
+	38, // 28: banner.BannerStats.period_start:type_name -> google.protobuf.Timestamp
+	35, // 29: banner.StatsResponse.stats:type_name -> banner.BannerStats
+	12, // 30: banner.BannersRotation.AddBanner:input_type -> banner.AddBannerRequest
+	13, // 31: banner.BannersRotation.RemoveBanner:input_type -> banner.RemoveBannerRequest
+	14, // 32: banner.BannersRotation.ClickEvent:input_type -> banner.ClickEventRequest
+	15, // 33: banner.BannersRotation.GetBanner:input_type -> banner.GetBannerRequest
+	16, // 34: banner.BannersRotation.GetBannersForPage:input_type -> banner.GetBannersForPageRequest
+	10, // 35: banner.BannersRotation.CreateBanner:input_type -> banner.BannerRequest
+	9,  // 36: banner.BannersRotation.CreateSlot:input_type -> banner.SlotRequest
+	11, // 37: banner.BannersRotation.CreateSocialDemo:input_type -> banner.SocialDemoRequest
+	22, // 38: banner.BannersRotation.ReadBanner:input_type -> banner.ReadRequest
+	28, // 39: banner.BannersRotation.ListBanners:input_type -> banner.ListRequest
+	10, // 40: banner.BannersRotation.UpdateBanner:input_type -> banner.BannerRequest
+	29, // 41: banner.BannersRotation.DeleteBanner:input_type -> banner.DeleteRequest
+	22, // 42: banner.BannersRotation.ReadSlot:input_type -> banner.ReadRequest
+	28, // 43: banner.BannersRotation.ListSlots:input_type -> banner.ListRequest
+	9,  // 44: banner.BannersRotation.UpdateSlot:input_type -> banner.SlotRequest
+	29, // 45: banner.BannersRotation.DeleteSlot:input_type -> banner.DeleteRequest
+	22, // 46: banner.BannersRotation.ReadSocialDemo:input_type -> banner.ReadRequest
+	28, // 47: banner.BannersRotation.ListSocialDemos:input_type -> banner.ListRequest
+	11, // 48: banner.BannersRotation.UpdateSocialDemo:input_type -> banner.SocialDemoRequest
+	29, // 49: banner.BannersRotation.DeleteSocialDemo:input_type -> banner.DeleteRequest
+	28, // 50: banner.BannersRotation.ListRotations:input_type -> banner.ListRequest
+	27, // 51: banner.BannersRotation.ScheduleRotation:input_type -> banner.ScheduleRotationRequest
+	26, // 52: banner.BannersRotation.SetFrequencyCap:input_type -> banner.FrequencyCapRequest
+	25, // 53: banner.BannersRotation.GetTargeting:input_type -> banner.RotationRequest
+	24, // 54: banner.BannersRotation.SetTargeting:input_type -> banner.Targeting
+	34, // 55: banner.BannersRotation.GetStats:input_type -> banner.StatsRequest
+	3,  // 56: banner.BannersRotation.AddBanner:output_type -> banner.MessageResponse
+	3,  // 57: banner.BannersRotation.RemoveBanner:output_type -> banner.MessageResponse
+	3,  // 58: banner.BannersRotation.ClickEvent:output_type -> banner.MessageResponse
+	4,  // 59: banner.BannersRotation.GetBanner:output_type -> banner.BannerResponse
+	18, // 60: banner.BannersRotation.GetBannersForPage:output_type -> banner.GetBannersForPageResponse
+	4,  // 61: banner.BannersRotation.CreateBanner:output_type -> banner.BannerResponse
+	7,  // 62: banner.BannersRotation.CreateSlot:output_type -> banner.SlotResponse
+	8,  // 63: banner.BannersRotation.CreateSocialDemo:output_type -> banner.SocialDemoResponse
+	19, // 64: banner.BannersRotation.ReadBanner:output_type -> banner.Banner
+	30, // 65: banner.BannersRotation.ListBanners:output_type -> banner.BannersResponse
+	19, // 66: banner.BannersRotation.UpdateBanner:output_type -> banner.Banner
+	3,  // 67: banner.BannersRotation.DeleteBanner:output_type -> banner.MessageResponse
+	20, // 68: banner.BannersRotation.ReadSlot:output_type -> banner.Slot
+	31, // 69: banner.BannersRotation.ListSlots:output_type -> banner.SlotsResponse
+	20, // 70: banner.BannersRotation.UpdateSlot:output_type -> banner.Slot
+	3,  // 71: banner.BannersRotation.DeleteSlot:output_type -> banner.MessageResponse
+	21, // 72: banner.BannersRotation.ReadSocialDemo:output_type -> banner.SocialDemo
+	33, // 73: banner.BannersRotation.ListSocialDemos:output_type -> banner.SocialDemosResponse
+	21, // 74: banner.BannersRotation.UpdateSocialDemo:output_type -> banner.SocialDemo
+	3,  // 75: banner.BannersRotation.DeleteSocialDemo:output_type -> banner.MessageResponse
+	32, // 76: banner.BannersRotation.ListRotations:output_type -> banner.RotationsResponse
+	3,  // 77: banner.BannersRotation.ScheduleRotation:output_type -> banner.MessageResponse
+	3,  // 78: banner.BannersRotation.SetFrequencyCap:output_type -> banner.MessageResponse
+	24, // 79: banner.BannersRotation.GetTargeting:output_type -> banner.Targeting
+	24, // 80: banner.BannersRotation.SetTargeting:output_type -> banner.Targeting
+	36, // 81: banner.BannersRotation.GetStats:output_type -> banner.StatsResponse
+	56, // [56:82] is the sub-list for method output_type
+	30, // [30:56] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_api_banner_proto_init() }
@@ -756,6 +3379,8 @@ func file_api_banner_proto_init() {
 	if File_api_banner_proto != nil {
 		return
 	}
+	file_api_validate_proto_init()
+	file_api_access_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_api_banner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MessageResponse); i {
@@ -782,7 +3407,7 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SlotResponse); i {
+			switch v := v.(*Creative); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -794,7 +3419,7 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SocialDemoResponse); i {
+			switch v := v.(*Size); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -806,7 +3431,7 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SlotRequest); i {
+			switch v := v.(*SlotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -818,7 +3443,7 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BannerRequest); i {
+			switch v := v.(*SocialDemoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -830,7 +3455,7 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SocialDemoRequest); i {
+			switch v := v.(*SlotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -842,7 +3467,7 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddBannerRequest); i {
+			switch v := v.(*BannerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -854,7 +3479,7 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveBannerRequest); i {
+			switch v := v.(*SocialDemoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -866,7 +3491,7 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClickEventRequest); i {
+			switch v := v.(*AddBannerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -878,6 +3503,30 @@ func file_api_banner_proto_init() {
 			}
 		}
 		file_api_banner_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBannerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClickEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBannerRequest); i {
 			case 0:
 				return &v.state
@@ -889,19 +3538,272 @@ func file_api_banner_proto_init() {
 				return nil
 			}
 		}
+		file_api_banner_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBannersForPageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageBanner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBannersForPageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Banner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Slot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SocialDemo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Targeting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrequencyCapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleRotationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BannersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SocialDemosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BannerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_banner_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_banner_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_banner_proto_goTypes,
 		DependencyIndexes: file_api_banner_proto_depIdxs,
+		EnumInfos:         file_api_banner_proto_enumTypes,
 		MessageInfos:      file_api_banner_proto_msgTypes,
 	}.Build()
 	File_api_banner_proto = out.File
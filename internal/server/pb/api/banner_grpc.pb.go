@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.17.3
+// source: api/banner.proto
 
 package pb
 
@@ -22,9 +26,28 @@ type BannersRotationClient interface {
 	RemoveBanner(ctx context.Context, in *RemoveBannerRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	ClickEvent(ctx context.Context, in *ClickEventRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*BannerResponse, error)
+	GetBannersForPage(ctx context.Context, in *GetBannersForPageRequest, opts ...grpc.CallOption) (*GetBannersForPageResponse, error)
 	CreateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*BannerResponse, error)
 	CreateSlot(ctx context.Context, in *SlotRequest, opts ...grpc.CallOption) (*SlotResponse, error)
 	CreateSocialDemo(ctx context.Context, in *SocialDemoRequest, opts ...grpc.CallOption) (*SocialDemoResponse, error)
+	ReadBanner(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*Banner, error)
+	ListBanners(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*BannersResponse, error)
+	UpdateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*Banner, error)
+	DeleteBanner(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	ReadSlot(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*Slot, error)
+	ListSlots(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SlotsResponse, error)
+	UpdateSlot(ctx context.Context, in *SlotRequest, opts ...grpc.CallOption) (*Slot, error)
+	DeleteSlot(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	ReadSocialDemo(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*SocialDemo, error)
+	ListSocialDemos(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SocialDemosResponse, error)
+	UpdateSocialDemo(ctx context.Context, in *SocialDemoRequest, opts ...grpc.CallOption) (*SocialDemo, error)
+	DeleteSocialDemo(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	ListRotations(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*RotationsResponse, error)
+	ScheduleRotation(ctx context.Context, in *ScheduleRotationRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	SetFrequencyCap(ctx context.Context, in *FrequencyCapRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	GetTargeting(ctx context.Context, in *RotationRequest, opts ...grpc.CallOption) (*Targeting, error)
+	SetTargeting(ctx context.Context, in *Targeting, opts ...grpc.CallOption) (*Targeting, error)
+	GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
 }
 
 type bannersRotationClient struct {
@@ -71,6 +94,15 @@ func (c *bannersRotationClient) GetBanner(ctx context.Context, in *GetBannerRequ
 	return out, nil
 }
 
+func (c *bannersRotationClient) GetBannersForPage(ctx context.Context, in *GetBannersForPageRequest, opts ...grpc.CallOption) (*GetBannersForPageResponse, error) {
+	out := new(GetBannersForPageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetBannersForPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bannersRotationClient) CreateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*BannerResponse, error) {
 	out := new(BannerResponse)
 	err := c.cc.Invoke(ctx, "/banner.BannersRotation/CreateBanner", in, out, opts...)
@@ -98,6 +130,168 @@ func (c *bannersRotationClient) CreateSocialDemo(ctx context.Context, in *Social
 	return out, nil
 }
 
+func (c *bannersRotationClient) ReadBanner(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*Banner, error) {
+	out := new(Banner)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ReadBanner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ListBanners(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*BannersResponse, error) {
+	out := new(BannersResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ListBanners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) UpdateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*Banner, error) {
+	out := new(Banner)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/UpdateBanner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) DeleteBanner(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/DeleteBanner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ReadSlot(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*Slot, error) {
+	out := new(Slot)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ReadSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ListSlots(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SlotsResponse, error) {
+	out := new(SlotsResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ListSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) UpdateSlot(ctx context.Context, in *SlotRequest, opts ...grpc.CallOption) (*Slot, error) {
+	out := new(Slot)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/UpdateSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) DeleteSlot(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/DeleteSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ReadSocialDemo(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*SocialDemo, error) {
+	out := new(SocialDemo)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ReadSocialDemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ListSocialDemos(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SocialDemosResponse, error) {
+	out := new(SocialDemosResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ListSocialDemos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) UpdateSocialDemo(ctx context.Context, in *SocialDemoRequest, opts ...grpc.CallOption) (*SocialDemo, error) {
+	out := new(SocialDemo)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/UpdateSocialDemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) DeleteSocialDemo(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/DeleteSocialDemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ListRotations(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*RotationsResponse, error) {
+	out := new(RotationsResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ListRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ScheduleRotation(ctx context.Context, in *ScheduleRotationRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ScheduleRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) SetFrequencyCap(ctx context.Context, in *FrequencyCapRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/SetFrequencyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) GetTargeting(ctx context.Context, in *RotationRequest, opts ...grpc.CallOption) (*Targeting, error) {
+	out := new(Targeting)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetTargeting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) SetTargeting(ctx context.Context, in *Targeting, opts ...grpc.CallOption) (*Targeting, error) {
+	out := new(Targeting)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/SetTargeting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BannersRotationServer is the server API for BannersRotation service.
 // All implementations must embed UnimplementedBannersRotationServer
 // for forward compatibility
@@ -106,9 +300,28 @@ type BannersRotationServer interface {
 	RemoveBanner(context.Context, *RemoveBannerRequest) (*MessageResponse, error)
 	ClickEvent(context.Context, *ClickEventRequest) (*MessageResponse, error)
 	GetBanner(context.Context, *GetBannerRequest) (*BannerResponse, error)
+	GetBannersForPage(context.Context, *GetBannersForPageRequest) (*GetBannersForPageResponse, error)
 	CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error)
 	CreateSlot(context.Context, *SlotRequest) (*SlotResponse, error)
 	CreateSocialDemo(context.Context, *SocialDemoRequest) (*SocialDemoResponse, error)
+	ReadBanner(context.Context, *ReadRequest) (*Banner, error)
+	ListBanners(context.Context, *ListRequest) (*BannersResponse, error)
+	UpdateBanner(context.Context, *BannerRequest) (*Banner, error)
+	DeleteBanner(context.Context, *DeleteRequest) (*MessageResponse, error)
+	ReadSlot(context.Context, *ReadRequest) (*Slot, error)
+	ListSlots(context.Context, *ListRequest) (*SlotsResponse, error)
+	UpdateSlot(context.Context, *SlotRequest) (*Slot, error)
+	DeleteSlot(context.Context, *DeleteRequest) (*MessageResponse, error)
+	ReadSocialDemo(context.Context, *ReadRequest) (*SocialDemo, error)
+	ListSocialDemos(context.Context, *ListRequest) (*SocialDemosResponse, error)
+	UpdateSocialDemo(context.Context, *SocialDemoRequest) (*SocialDemo, error)
+	DeleteSocialDemo(context.Context, *DeleteRequest) (*MessageResponse, error)
+	ListRotations(context.Context, *ListRequest) (*RotationsResponse, error)
+	ScheduleRotation(context.Context, *ScheduleRotationRequest) (*MessageResponse, error)
+	SetFrequencyCap(context.Context, *FrequencyCapRequest) (*MessageResponse, error)
+	GetTargeting(context.Context, *RotationRequest) (*Targeting, error)
+	SetTargeting(context.Context, *Targeting) (*Targeting, error)
+	GetStats(context.Context, *StatsRequest) (*StatsResponse, error)
 	mustEmbedUnimplementedBannersRotationServer()
 }
 
@@ -128,6 +341,9 @@ func (UnimplementedBannersRotationServer) ClickEvent(context.Context, *ClickEven
 func (UnimplementedBannersRotationServer) GetBanner(context.Context, *GetBannerRequest) (*BannerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBanner not implemented")
 }
+func (UnimplementedBannersRotationServer) GetBannersForPage(context.Context, *GetBannersForPageRequest) (*GetBannersForPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBannersForPage not implemented")
+}
 func (UnimplementedBannersRotationServer) CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBanner not implemented")
 }
@@ -137,6 +353,60 @@ func (UnimplementedBannersRotationServer) CreateSlot(context.Context, *SlotReque
 func (UnimplementedBannersRotationServer) CreateSocialDemo(context.Context, *SocialDemoRequest) (*SocialDemoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSocialDemo not implemented")
 }
+func (UnimplementedBannersRotationServer) ReadBanner(context.Context, *ReadRequest) (*Banner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadBanner not implemented")
+}
+func (UnimplementedBannersRotationServer) ListBanners(context.Context, *ListRequest) (*BannersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBanners not implemented")
+}
+func (UnimplementedBannersRotationServer) UpdateBanner(context.Context, *BannerRequest) (*Banner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBanner not implemented")
+}
+func (UnimplementedBannersRotationServer) DeleteBanner(context.Context, *DeleteRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBanner not implemented")
+}
+func (UnimplementedBannersRotationServer) ReadSlot(context.Context, *ReadRequest) (*Slot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSlot not implemented")
+}
+func (UnimplementedBannersRotationServer) ListSlots(context.Context, *ListRequest) (*SlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlots not implemented")
+}
+func (UnimplementedBannersRotationServer) UpdateSlot(context.Context, *SlotRequest) (*Slot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSlot not implemented")
+}
+func (UnimplementedBannersRotationServer) DeleteSlot(context.Context, *DeleteRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSlot not implemented")
+}
+func (UnimplementedBannersRotationServer) ReadSocialDemo(context.Context, *ReadRequest) (*SocialDemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSocialDemo not implemented")
+}
+func (UnimplementedBannersRotationServer) ListSocialDemos(context.Context, *ListRequest) (*SocialDemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSocialDemos not implemented")
+}
+func (UnimplementedBannersRotationServer) UpdateSocialDemo(context.Context, *SocialDemoRequest) (*SocialDemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSocialDemo not implemented")
+}
+func (UnimplementedBannersRotationServer) DeleteSocialDemo(context.Context, *DeleteRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSocialDemo not implemented")
+}
+func (UnimplementedBannersRotationServer) ListRotations(context.Context, *ListRequest) (*RotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRotations not implemented")
+}
+func (UnimplementedBannersRotationServer) ScheduleRotation(context.Context, *ScheduleRotationRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRotation not implemented")
+}
+func (UnimplementedBannersRotationServer) SetFrequencyCap(context.Context, *FrequencyCapRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFrequencyCap not implemented")
+}
+func (UnimplementedBannersRotationServer) GetTargeting(context.Context, *RotationRequest) (*Targeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargeting not implemented")
+}
+func (UnimplementedBannersRotationServer) SetTargeting(context.Context, *Targeting) (*Targeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTargeting not implemented")
+}
+func (UnimplementedBannersRotationServer) GetStats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
 func (UnimplementedBannersRotationServer) mustEmbedUnimplementedBannersRotationServer() {}
 
 // UnsafeBannersRotationServer may be embedded to opt out of forward compatibility for this service.
@@ -222,6 +492,24 @@ func _BannersRotation_GetBanner_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BannersRotation_GetBannersForPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBannersForPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).GetBannersForPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/GetBannersForPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).GetBannersForPage(ctx, req.(*GetBannersForPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BannersRotation_CreateBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BannerRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +564,330 @@ func _BannersRotation_CreateSocialDemo_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BannersRotation_ReadBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ReadBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ReadBanner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ReadBanner(ctx, req.(*ReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ListBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ListBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ListBanners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ListBanners(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_UpdateBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).UpdateBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/UpdateBanner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).UpdateBanner(ctx, req.(*BannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_DeleteBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).DeleteBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/DeleteBanner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).DeleteBanner(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ReadSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ReadSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ReadSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ReadSlot(ctx, req.(*ReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ListSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ListSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ListSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ListSlots(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_UpdateSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).UpdateSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/UpdateSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).UpdateSlot(ctx, req.(*SlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_DeleteSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).DeleteSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/DeleteSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).DeleteSlot(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ReadSocialDemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ReadSocialDemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ReadSocialDemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ReadSocialDemo(ctx, req.(*ReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ListSocialDemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ListSocialDemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ListSocialDemos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ListSocialDemos(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_UpdateSocialDemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SocialDemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).UpdateSocialDemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/UpdateSocialDemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).UpdateSocialDemo(ctx, req.(*SocialDemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_DeleteSocialDemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).DeleteSocialDemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/DeleteSocialDemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).DeleteSocialDemo(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ListRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ListRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ListRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ListRotations(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ScheduleRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ScheduleRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ScheduleRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ScheduleRotation(ctx, req.(*ScheduleRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_SetFrequencyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FrequencyCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).SetFrequencyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/SetFrequencyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).SetFrequencyCap(ctx, req.(*FrequencyCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_GetTargeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).GetTargeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/GetTargeting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).GetTargeting(ctx, req.(*RotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_SetTargeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Targeting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).SetTargeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/SetTargeting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).SetTargeting(ctx, req.(*Targeting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).GetStats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BannersRotation_ServiceDesc is the grpc.ServiceDesc for BannersRotation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -299,6 +911,10 @@ var BannersRotation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBanner",
 			Handler:    _BannersRotation_GetBanner_Handler,
 		},
+		{
+			MethodName: "GetBannersForPage",
+			Handler:    _BannersRotation_GetBannersForPage_Handler,
+		},
 		{
 			MethodName: "CreateBanner",
 			Handler:    _BannersRotation_CreateBanner_Handler,
@@ -311,6 +927,78 @@ var BannersRotation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSocialDemo",
 			Handler:    _BannersRotation_CreateSocialDemo_Handler,
 		},
+		{
+			MethodName: "ReadBanner",
+			Handler:    _BannersRotation_ReadBanner_Handler,
+		},
+		{
+			MethodName: "ListBanners",
+			Handler:    _BannersRotation_ListBanners_Handler,
+		},
+		{
+			MethodName: "UpdateBanner",
+			Handler:    _BannersRotation_UpdateBanner_Handler,
+		},
+		{
+			MethodName: "DeleteBanner",
+			Handler:    _BannersRotation_DeleteBanner_Handler,
+		},
+		{
+			MethodName: "ReadSlot",
+			Handler:    _BannersRotation_ReadSlot_Handler,
+		},
+		{
+			MethodName: "ListSlots",
+			Handler:    _BannersRotation_ListSlots_Handler,
+		},
+		{
+			MethodName: "UpdateSlot",
+			Handler:    _BannersRotation_UpdateSlot_Handler,
+		},
+		{
+			MethodName: "DeleteSlot",
+			Handler:    _BannersRotation_DeleteSlot_Handler,
+		},
+		{
+			MethodName: "ReadSocialDemo",
+			Handler:    _BannersRotation_ReadSocialDemo_Handler,
+		},
+		{
+			MethodName: "ListSocialDemos",
+			Handler:    _BannersRotation_ListSocialDemos_Handler,
+		},
+		{
+			MethodName: "UpdateSocialDemo",
+			Handler:    _BannersRotation_UpdateSocialDemo_Handler,
+		},
+		{
+			MethodName: "DeleteSocialDemo",
+			Handler:    _BannersRotation_DeleteSocialDemo_Handler,
+		},
+		{
+			MethodName: "ListRotations",
+			Handler:    _BannersRotation_ListRotations_Handler,
+		},
+		{
+			MethodName: "ScheduleRotation",
+			Handler:    _BannersRotation_ScheduleRotation_Handler,
+		},
+		{
+			MethodName: "SetFrequencyCap",
+			Handler:    _BannersRotation_SetFrequencyCap_Handler,
+		},
+		{
+			MethodName: "GetTargeting",
+			Handler:    _BannersRotation_GetTargeting_Handler,
+		},
+		{
+			MethodName: "SetTargeting",
+			Handler:    _BannersRotation_SetTargeting_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _BannersRotation_GetStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/banner.proto",
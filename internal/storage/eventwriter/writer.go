@@ -0,0 +1,232 @@
+package eventwriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/app"
+	"github.com/VladimirButakov/otus-project/internal/metrics"
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+)
+
+var ErrNotPositive = errors.New("must be positive")
+
+type Logger interface {
+	Error(msg string, keysAndValues ...interface{})
+}
+
+type Storage interface {
+	app.TxStorage
+	AddViewEvents(ctx context.Context, views []sqlstorage.ViewItem) error
+	AddClickEvents(ctx context.Context, clicks []sqlstorage.ClickItem) error
+}
+
+type event struct {
+	click bool
+	item  sqlstorage.ViewItem
+}
+
+// txKey marks contexts of transactions started by WithTx.
+type txKey struct{}
+
+// Writer is a storage which records view and click events asynchronously.
+// Events recorded outside transactions are put on a bounded queue and inserted
+// in batches when the batch is full, on every flush interval and on Stop. Events
+// which do not fit in the queue or fail to be inserted are dropped and counted.
+type Writer struct {
+	Storage
+
+	logger        Logger
+	queue         chan event
+	batchSize     int
+	flushInterval time.Duration
+	dropped       uint64
+	// mu guards stopped, so an event is either queued before Run drains the
+	// queue on Stop or written synchronously after it.
+	mu      sync.RWMutex
+	stopped bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+func New(logger Logger, storage Storage, queueSize int, batchSize int, flushInterval time.Duration) (*Writer, error) {
+	if queueSize <= 0 {
+		return nil, fmt.Errorf("cannot use queue size %d, %w", queueSize, ErrNotPositive)
+	}
+
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("cannot use batch size %d, %w", batchSize, ErrNotPositive)
+	}
+
+	if flushInterval <= 0 {
+		return nil, fmt.Errorf("cannot use flush interval %s, %w", flushInterval, ErrNotPositive)
+	}
+
+	return &Writer{
+		Storage:       storage,
+		logger:        logger,
+		queue:         make(chan event, queueSize),
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}, nil
+}
+
+// QueueDepth returns the number of events waiting to be written.
+func (w *Writer) QueueDepth() int {
+	return len(w.queue)
+}
+
+// Dropped returns the number of events lost because the queue was full or the insert failed.
+func (w *Writer) Dropped() uint64 {
+	return atomic.LoadUint64(&w.dropped)
+}
+
+func (w *Writer) AddViewEvent(ctx context.Context, bannerID string, slotID string, socialDemoID string, date string) error {
+	if w.add(ctx, event{item: sqlstorage.ViewItem{SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date}}) {
+		return nil
+	}
+
+	return w.Storage.AddViewEvent(ctx, bannerID, slotID, socialDemoID, date)
+}
+
+func (w *Writer) AddClickEvent(ctx context.Context, bannerID string, slotID string, socialDemoID string, date string) error {
+	if w.add(ctx, event{click: true, item: sqlstorage.ViewItem{SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date}}) {
+		return nil
+	}
+
+	return w.Storage.AddClickEvent(ctx, bannerID, slotID, socialDemoID, date)
+}
+
+// WithTx runs fn in a transaction of the storage. Events recorded by fn are
+// written synchronously in the transaction, so the selection of the next banner
+// sees them and they are committed or rolled back with the rest of fn.
+func (w *Writer) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return w.Storage.WithTx(ctx, func(ctx context.Context) error {
+		return fn(context.WithValue(ctx, txKey{}, true))
+	})
+}
+
+// add queues the event. It returns false when the event must be written
+// synchronously, because it is recorded in a transaction or the writer is stopped.
+func (w *Writer) add(ctx context.Context, e event) bool {
+	if inTx, _ := ctx.Value(txKey{}).(bool); inTx {
+		return false
+	}
+
+	return w.enqueue(e)
+}
+
+// enqueue queues the event, dropping it when the queue is full. It returns
+// false when the writer is stopped.
+func (w *Writer) enqueue(e event) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.stopped {
+		return false
+	}
+
+	select {
+	case w.queue <- e:
+	default:
+		w.drop(1)
+	}
+
+	return true
+}
+
+func (w *Writer) drop(count int) {
+	atomic.AddUint64(&w.dropped, uint64(count))
+	metrics.EventsDropped.Add(float64(count))
+}
+
+// Run writes queued events until Stop is called.
+func (w *Writer) Run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]event, 0, w.batchSize)
+
+	for {
+		select {
+		case e := <-w.queue:
+			batch = append(batch, e)
+			if len(batch) < w.batchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-w.stop:
+			for len(w.queue) > 0 {
+				batch = append(batch, <-w.queue)
+			}
+
+			w.flush(batch)
+			metrics.EventQueueDepth.Set(0)
+
+			return
+		}
+
+		w.flush(batch)
+		batch = batch[:0]
+
+		metrics.EventQueueDepth.Set(float64(len(w.queue)))
+	}
+}
+
+// Stop switches the writer to synchronous writes and flushes the queued events.
+func (w *Writer) Stop(ctx context.Context) error {
+	w.mu.Lock()
+
+	if !w.stopped {
+		w.stopped = true
+		close(w.stop)
+	}
+
+	w.mu.Unlock()
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("cannot flush events, %w", ctx.Err())
+	}
+}
+
+func (w *Writer) flush(batch []event) {
+	if len(batch) == 0 {
+		return
+	}
+
+	var (
+		views  []sqlstorage.ViewItem
+		clicks []sqlstorage.ClickItem
+	)
+
+	for _, e := range batch {
+		if e.click {
+			clicks = append(clicks, sqlstorage.ClickItem(e.item))
+		} else {
+			views = append(views, e.item)
+		}
+	}
+
+	ctx := context.Background()
+
+	if err := w.Storage.AddViewEvents(ctx, views); err != nil {
+		w.drop(len(views))
+		w.logger.Error(err.Error())
+	}
+
+	if err := w.Storage.AddClickEvents(ctx, clicks); err != nil {
+		w.drop(len(clicks))
+		w.logger.Error(err.Error())
+	}
+}
@@ -0,0 +1,156 @@
+package eventwriter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sqlitestorage "github.com/VladimirButakov/otus-project/internal/storage/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+type logger struct{}
+
+func (l logger) Error(msg string, keysAndValues ...interface{}) {}
+
+func TestWriter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("test events are flushed on stop", func(t *testing.T) {
+		storage, err := sqlitestorage.New(ctx, ":memory:", time.Second)
+		require.NoError(t, err, "should be without errors")
+
+		defer storage.Close()
+
+		writer, err := New(logger{}, storage, 100, 10, time.Hour)
+		require.NoError(t, err, "should be without errors")
+		go writer.Run()
+
+		for i := 0; i < 25; i++ {
+			err := writer.AddViewEvent(ctx, "banner1", "slot1", "social_demo1", time.Now().String())
+			require.NoError(t, err, "should be without errors")
+		}
+
+		err = writer.AddClickEvent(ctx, "banner1", "slot1", "social_demo1", time.Now().String())
+		require.NoError(t, err, "should be without errors")
+
+		err = writer.Stop(ctx)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, 0, writer.QueueDepth(), "queue should be empty")
+
+		counts, err := storage.GetEventCountsInSlots(ctx, []string{"slot1"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, counts, 1)
+		require.Equal(t, 25, counts[0].Views, "all views should be written")
+		require.Equal(t, 1, counts[0].Clicks, "click should be written")
+	})
+
+	t.Run("test full queue drops events", func(t *testing.T) {
+		storage, err := sqlitestorage.New(ctx, ":memory:", time.Second)
+		require.NoError(t, err, "should be without errors")
+
+		defer storage.Close()
+
+		writer, err := New(logger{}, storage, 2, 10, time.Hour)
+		require.NoError(t, err, "should be without errors")
+
+		for i := 0; i < 5; i++ {
+			err := writer.AddViewEvent(ctx, "banner1", "slot1", "social_demo1", time.Now().String())
+			require.NoError(t, err, "should be without errors")
+		}
+
+		require.Equal(t, 2, writer.QueueDepth())
+		require.Equal(t, uint64(3), writer.Dropped())
+	})
+
+	t.Run("test transaction events are written synchronously", func(t *testing.T) {
+		storage, err := sqlitestorage.New(ctx, ":memory:", time.Second)
+		require.NoError(t, err, "should be without errors")
+
+		defer storage.Close()
+
+		writer, err := New(logger{}, storage, 10, 10, time.Hour)
+		require.NoError(t, err, "should be without errors")
+		errAbort := errors.New("abort")
+
+		err = writer.WithTx(ctx, func(ctx context.Context) error {
+			err := writer.AddViewEvent(ctx, "banner1", "slot1", "social_demo1", time.Now().String())
+			require.NoError(t, err, "should be without errors")
+
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+		require.Equal(t, 0, writer.QueueDepth(), "events should not be queued")
+
+		counts, err := storage.GetEventCountsInSlots(ctx, []string{"slot1"})
+		require.NoError(t, err, "should be without errors")
+		require.Empty(t, counts, "events of rolled back transaction should not be written")
+
+		err = writer.WithTx(ctx, func(ctx context.Context) error {
+			if err := writer.AddViewEvent(ctx, "banner1", "slot1", "social_demo1", time.Now().String()); err != nil {
+				return err
+			}
+
+			counts, err := storage.GetEventCountsInSlots(ctx, []string{"slot1"})
+			require.NoError(t, err, "should be without errors")
+			require.Len(t, counts, 1, "view should be seen in the transaction")
+
+			return nil
+		})
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, 0, writer.QueueDepth(), "events should not be queued")
+
+		counts, err = storage.GetEventCountsInSlots(ctx, []string{"slot1"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, counts, 1)
+		require.Equal(t, 1, counts[0].Views, "view should be committed")
+	})
+
+	t.Run("test events racing stop are written", func(t *testing.T) {
+		storage, err := sqlitestorage.New(ctx, ":memory:", time.Second)
+		require.NoError(t, err, "should be without errors")
+
+		defer storage.Close()
+
+		writer, err := New(logger{}, storage, 1000, 10, time.Hour)
+		require.NoError(t, err, "should be without errors")
+
+		go writer.Run()
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for j := 0; j < 50; j++ {
+					require.NoError(t, writer.AddViewEvent(ctx, "banner1", "slot1", "social_demo1", time.Now().String()))
+				}
+			}()
+		}
+
+		require.NoError(t, writer.Stop(ctx), "should be without errors")
+		wg.Wait()
+
+		counts, err := storage.GetEventCountsInSlots(ctx, []string{"slot1"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, counts, 1)
+		require.Equal(t, 200, counts[0].Views, "events should not be lost")
+		require.Zero(t, writer.Dropped(), "events should not be dropped")
+	})
+
+	t.Run("test invalid settings", func(t *testing.T) {
+		_, err := New(logger{}, nil, 10, 10, 0)
+		require.ErrorIs(t, err, ErrNotPositive, "zero flush interval should fail")
+
+		_, err = New(logger{}, nil, 10, 0, time.Second)
+		require.ErrorIs(t, err, ErrNotPositive, "zero batch size should fail")
+
+		_, err = New(logger{}, nil, 0, 10, time.Second)
+		require.ErrorIs(t, err, ErrNotPositive, "zero queue size should fail")
+	})
+}
@@ -0,0 +1,120 @@
+package sqlstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Creative describes what is shown for a banner.
+type Creative struct {
+	ImageURL   string `db:"image_url"`
+	LandingURL string `db:"landing_url"`
+	AltText    string `db:"alt_text"`
+	Width      int    `db:"width"`
+	Height     int    `db:"height"`
+	MimeType   string `db:"mime_type"`
+}
+
+// Size is a creative size accepted by a slot.
+type Size struct {
+	Width  int `db:"width"`
+	Height int `db:"height"`
+}
+
+type slotSizeItem struct {
+	SlotID string `db:"slot_id"`
+	Size
+}
+
+// GetBanners returns the banners with the given ids, in no particular order.
+func (s *Storage) GetBanners(ctx context.Context, ids []string) (banners []BannerItem, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sqlx.In("SELECT "+bannerColumns+" FROM banners WHERE id IN (?)", ids)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build banners query, %w", err)
+	}
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if err := s.querier(ctx).SelectContext(ctx, &banners, s.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("cannot get banners, %w", queryError(ctx, err))
+	}
+
+	return banners, nil
+}
+
+// GetSlotSizes returns the sizes accepted by the slot. A slot without sizes accepts any banner.
+func (s *Storage) GetSlotSizes(ctx context.Context, slotID string) (sizes []Size, err error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	err = s.querier(ctx).SelectContext(ctx, &sizes, "SELECT width,height FROM slot_sizes WHERE slot_id=$1 ORDER BY width,height", slotID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get sizes of slot %s, %w", slotID, queryError(ctx, err))
+	}
+
+	return sizes, nil
+}
+
+// setSlotSizes replaces the sizes accepted by the slot.
+func (s *Storage) setSlotSizes(ctx context.Context, slotID string, sizes []Size) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := s.querier(ctx).ExecContext(ctx, "DELETE FROM slot_sizes WHERE slot_id=$1", slotID); err != nil {
+		return fmt.Errorf("cannot delete sizes of slot %s, %w", slotID, queryError(ctx, err))
+	}
+
+	for _, size := range sizes {
+		_, err := s.querier(ctx).ExecContext(ctx, "INSERT INTO slot_sizes (slot_id,width,height) VALUES ($1,$2,$3) ON CONFLICT DO NOTHING",
+			slotID, size.Width, size.Height)
+		if err != nil {
+			return fmt.Errorf("cannot insert size of slot %s, %w", slotID, queryError(ctx, err))
+		}
+	}
+
+	return nil
+}
+
+// loadSlotSizes sets the sizes of a page of slots with a single query.
+func (s *Storage) loadSlotSizes(ctx context.Context, slots []SlotItem) error {
+	if len(slots) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		ids = append(ids, slot.ID)
+	}
+
+	query, args, err := sqlx.In("SELECT slot_id,width,height FROM slot_sizes WHERE slot_id IN (?) ORDER BY slot_id,width,height", ids)
+	if err != nil {
+		return fmt.Errorf("cannot build slot sizes query, %w", err)
+	}
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	var items []slotSizeItem
+
+	if err := s.selectRead(ctx, &items, s.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("cannot get slot sizes, %w", queryError(ctx, err))
+	}
+
+	sizes := make(map[string][]Size)
+	for _, item := range items {
+		sizes[item.SlotID] = append(sizes[item.SlotID], item.Size)
+	}
+
+	for i := range slots {
+		slots[i].Sizes = sizes[slots[i].ID]
+	}
+
+	return nil
+}
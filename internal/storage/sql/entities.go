@@ -0,0 +1,300 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type BannerItem struct {
+	ID          string `db:"id"`
+	Description string `db:"description"`
+	Creative
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// SlotItem is a slot. Sizes are the creative sizes it accepts, any when empty.
+type SlotItem struct {
+	ID          string    `db:"id"`
+	Description string    `db:"description"`
+	CreatedAt   time.Time `db:"created_at"`
+	Sizes       []Size    `db:"-"`
+}
+
+type SocialDemoItem struct {
+	ID          string    `db:"id"`
+	Description string    `db:"description"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+var (
+	ErrInRotation   = errors.New("is in rotation, use force to remove it from rotation")
+	ErrUnknownField = errors.New("unknown field")
+)
+
+const (
+	entityColumns = "id,description,created_at"
+	bannerColumns = entityColumns + ",image_url,landing_url,alt_text,width,height,mime_type"
+
+	// targetedRotations selects the rotation entries targeted at a social demo group.
+	targetedRotations = `EXISTS (SELECT 1 FROM rotation_targets WHERE rotation_targets.slot_id=banners_rotation.slot_id
+		AND rotation_targets.banner_id=banners_rotation.banner_id AND rotation_targets.social_demo_id=$1)`
+)
+
+func (s *Storage) GetBanner(ctx context.Context, id string) (banner BannerItem, err error) {
+	return banner, s.getEntity(ctx, &banner, "banners", bannerColumns, id)
+}
+
+// UpdateBanner sets the fields of a banner to their values in banner and returns the
+// updated banner. Fields are columns of banners: description and the creative columns.
+func (s *Storage) UpdateBanner(ctx context.Context, id string, banner BannerItem, fields []string) (BannerItem, error) {
+	values := map[string]interface{}{
+		"description": banner.Description,
+		"image_url":   banner.ImageURL,
+		"landing_url": banner.LandingURL,
+		"alt_text":    banner.AltText,
+		"width":       banner.Width,
+		"height":      banner.Height,
+		"mime_type":   banner.MimeType,
+	}
+
+	var updated BannerItem
+
+	err := s.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.updateEntity(ctx, "banners", id, values, fields); err != nil {
+			return err
+		}
+
+		return s.getEntity(ctx, &updated, "banners", bannerColumns, id)
+	})
+
+	return updated, err
+}
+
+// DeleteBanner deletes a banner. A banner in rotation is deleted only with force,
+// which removes it from all slots. Its events are kept.
+func (s *Storage) DeleteBanner(ctx context.Context, id string, force bool) error {
+	return s.deleteEntity(ctx, "banners", "banner_id=$1", id, force)
+}
+
+func (s *Storage) GetSlot(ctx context.Context, id string) (slot SlotItem, err error) {
+	if err := s.getEntity(ctx, &slot, "slots", entityColumns, id); err != nil {
+		return slot, err
+	}
+
+	slot.Sizes, err = s.GetSlotSizes(ctx, id)
+
+	return slot, err
+}
+
+// UpdateSlot sets the fields of a slot, description and sizes, to their values in slot
+// and returns the updated slot. Banners already in rotation are not checked against
+// the new sizes.
+func (s *Storage) UpdateSlot(ctx context.Context, id string, slot SlotItem, fields []string) (SlotItem, error) {
+	columns := make([]string, 0, len(fields))
+	setSizes := false
+
+	for _, field := range fields {
+		if field == "sizes" {
+			setSizes = true
+
+			continue
+		}
+
+		columns = append(columns, field)
+	}
+
+	var updated SlotItem
+
+	err := s.WithTx(ctx, func(ctx context.Context) (err error) {
+		if err := s.updateEntity(ctx, "slots", id, map[string]interface{}{"description": slot.Description}, columns); err != nil {
+			return err
+		}
+
+		if setSizes {
+			if err := s.setSlotSizes(ctx, id, slot.Sizes); err != nil {
+				return err
+			}
+		}
+
+		updated, err = s.GetSlot(ctx, id)
+
+		return err
+	})
+
+	return updated, err
+}
+
+// DeleteSlot deletes a slot. A slot with banners in rotation is deleted only with force,
+// which clears its rotation. Its events are kept.
+func (s *Storage) DeleteSlot(ctx context.Context, id string, force bool) error {
+	return s.deleteEntity(ctx, "slots", "slot_id=$1", id, force)
+}
+
+func (s *Storage) GetSocialDemo(ctx context.Context, id string) (socialDemo SocialDemoItem, err error) {
+	return socialDemo, s.getEntity(ctx, &socialDemo, "social_demos", entityColumns, id)
+}
+
+func (s *Storage) UpdateSocialDemo(ctx context.Context, id string, description string) error {
+	return s.updateEntity(ctx, "social_demos", id, map[string]interface{}{"description": description}, []string{"description"})
+}
+
+// DeleteSocialDemo deletes a social demo group. A group in targeting of banners in
+// rotation is deleted only with force, which drops it from their targeting. Banners
+// which were shown to that group only are removed from rotation, as dropping their
+// last include target would show them to every group. Its events are kept.
+func (s *Storage) DeleteSocialDemo(ctx context.Context, id string, force bool) error {
+	if !force {
+		return s.deleteEntity(ctx, "social_demos", targetedRotations, id, false)
+	}
+
+	return s.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.dropTargetsOf(ctx, id); err != nil {
+			return err
+		}
+
+		return s.deleteEntity(ctx, "social_demos", targetedRotations, id, false)
+	})
+}
+
+// dropTargetsOf removes the social demo group from targeting, and the rotation entries
+// it was the last include target of.
+func (s *Storage) dropTargetsOf(ctx context.Context, socialDemoID string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).ExecContext(ctx, `DELETE FROM banners_rotation WHERE EXISTS (SELECT 1 FROM rotation_targets t
+		WHERE t.slot_id=banners_rotation.slot_id AND t.banner_id=banners_rotation.banner_id AND t.social_demo_id=$1 AND NOT t.excluded)
+		AND NOT EXISTS (SELECT 1 FROM rotation_targets t WHERE t.slot_id=banners_rotation.slot_id
+		AND t.banner_id=banners_rotation.banner_id AND t.social_demo_id<>$1 AND NOT t.excluded)`, socialDemoID)
+	if err != nil {
+		return fmt.Errorf("cannot remove banners targeted at social demo %s from rotation, %w", socialDemoID, queryError(ctx, err))
+	}
+
+	_, err = s.querier(ctx).ExecContext(ctx, "DELETE FROM rotation_targets WHERE social_demo_id=$1", socialDemoID)
+	if err != nil {
+		return fmt.Errorf("cannot drop social demo %s from targeting, %w", socialDemoID, queryError(ctx, err))
+	}
+
+	return nil
+}
+
+func (s *Storage) getEntity(ctx context.Context, dest interface{}, table string, columns string, id string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	err := s.querier(ctx).GetContext(ctx, dest, "SELECT "+columns+" FROM "+table+" WHERE id=$1", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("cannot get %s %s, %w", table, id, ErrNotFound)
+	}
+
+	if err != nil {
+		return fmt.Errorf("cannot get %s %s, %w", table, id, queryError(ctx, err))
+	}
+
+	return nil
+}
+
+// updateEntity sets the columns of an entity of the table to their values. Without
+// columns, it only checks the entity exists.
+func (s *Storage) updateEntity(
+	ctx context.Context,
+	table string,
+	id string,
+	values map[string]interface{},
+	columns []string,
+) error {
+	assignments := make([]string, 0, len(columns))
+	args := make([]interface{}, 0, len(columns)+1)
+
+	for _, column := range columns {
+		value, ok := values[column]
+		if !ok {
+			return fmt.Errorf("cannot update %s %s, %w %s", table, id, ErrUnknownField, column)
+		}
+
+		args = append(args, value)
+		assignments = append(assignments, fmt.Sprintf("%s=$%d", column, len(args)))
+	}
+
+	if len(assignments) == 0 {
+		assignments = append(assignments, "id=id")
+	}
+
+	args = append(args, id)
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	result, err := s.querier(ctx).ExecContext(ctx,
+		fmt.Sprintf("UPDATE %s SET %s WHERE id=$%d", table, strings.Join(assignments, ","), len(args)), args...)
+	if err != nil {
+		return fmt.Errorf("cannot update %s %s, %w", table, id, queryError(ctx, err))
+	}
+
+	return checkAffected(result, table, id)
+}
+
+// deleteEntity deletes an entity of the table with the rotation entries matched by
+// the rotation condition on banners_rotation, which are removed only with force.
+func (s *Storage) deleteEntity(ctx context.Context, table string, rotation string, id string, force bool) error {
+	return s.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.removeFromRotation(ctx, table, rotation, id, force); err != nil {
+			return err
+		}
+
+		ctx, cancel := s.withTimeout(ctx)
+		defer cancel()
+
+		result, err := s.querier(ctx).ExecContext(ctx, "DELETE FROM "+table+" WHERE id=$1", id)
+		if err != nil {
+			return fmt.Errorf("cannot delete %s %s, %w", table, id, queryError(ctx, err))
+		}
+
+		return checkAffected(result, table, id)
+	})
+}
+
+func (s *Storage) removeFromRotation(ctx context.Context, table string, rotation string, id string, force bool) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if force {
+		_, err := s.querier(ctx).ExecContext(ctx, "DELETE FROM banners_rotation WHERE "+rotation, id)
+		if err != nil {
+			return fmt.Errorf("cannot remove %s %s from rotation, %w", table, id, queryError(ctx, err))
+		}
+
+		return nil
+	}
+
+	var inRotation bool
+
+	err := s.querier(ctx).GetContext(ctx, &inRotation,
+		"SELECT EXISTS (SELECT 1 FROM banners_rotation WHERE "+rotation+")", id)
+	if err != nil {
+		return fmt.Errorf("cannot check rotation of %s %s, %w", table, id, queryError(ctx, err))
+	}
+
+	if inRotation {
+		return fmt.Errorf("cannot delete %s %s, %w", table, id, ErrInRotation)
+	}
+
+	return nil
+}
+
+func checkAffected(result sql.Result, table string, id string) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("cannot get affected rows count, %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("cannot find %s %s, %w", table, id, ErrNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package sqlstorage
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrNotFound            = errors.New("not found")
+	ErrAlreadyExists       = errors.New("already exists")
+	ErrForeignKeyViolation = errors.New("referenced entity does not exist")
+)
+
+// constraintError returns the domain error of a unique or foreign key violation, or nil.
+func constraintError(err error) error {
+	var pqErr *pq.Error
+
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505":
+			return ErrAlreadyExists
+		case "23503":
+			return ErrForeignKeyViolation
+		}
+
+		return nil
+	}
+
+	// SQLite driver errors are typed only in cgo builds, so they are matched by message.
+	switch msg := err.Error(); {
+	case strings.Contains(msg, "UNIQUE constraint failed"):
+		return ErrAlreadyExists
+	case strings.Contains(msg, "FOREIGN KEY constraint failed"):
+		return ErrForeignKeyViolation
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package sqlstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FrequencyCap limits how many times a user is shown a banner per hour and per
+// day, the last 60 minutes and 24 hours. Zero means no limit.
+type FrequencyCap struct {
+	PerHour int `db:"cap_per_hour"`
+	PerDay  int `db:"cap_per_day"`
+}
+
+// UserViewItem holds the number of times a banner was shown to a user in a slot
+// in the last hour and day.
+type UserViewItem struct {
+	SlotID    string `db:"slot_id"`
+	BannerID  string `db:"banner_id"`
+	HourViews int    `db:"hour_views"`
+	DayViews  int    `db:"day_views"`
+}
+
+// SetFrequencyCap sets the frequency cap of a banner in rotation of the slot.
+func (s *Storage) SetFrequencyCap(ctx context.Context, bannerID string, slotID string, frequencyCap FrequencyCap) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	result, err := s.querier(ctx).ExecContext(ctx, "UPDATE banners_rotation SET cap_per_hour=$1,cap_per_day=$2 WHERE slot_id=$3 AND banner_id=$4",
+		frequencyCap.PerHour, frequencyCap.PerDay, slotID, bannerID)
+	if err != nil {
+		return fmt.Errorf("cannot set frequency cap, %w", queryError(ctx, err))
+	}
+
+	return checkAffected(result, "banners_rotation", slotID+"/"+bannerID)
+}
+
+// GetUserViews counts the impressions of every banner shown to the user in the
+// last day by slot, as frequency caps are set per rotation entry.
+func (s *Storage) GetUserViews(ctx context.Context, userID string, now time.Time) (views []UserViewItem, err error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	// user_id <> '' lets the planner use the partial index of identified users.
+	err = s.querier(ctx).SelectContext(ctx, &views, s.db.Rebind(`SELECT slot_id,banner_id,
+		SUM(CASE WHEN created_at>=? THEN 1 ELSE 0 END) AS hour_views,COUNT(*) AS day_views
+		FROM impressions WHERE user_id=? AND user_id<>'' AND created_at>=? GROUP BY slot_id,banner_id`),
+		s.timeArg(now.Add(-time.Hour)), userID, s.timeArg(now.Add(-24*time.Hour)))
+	if err != nil {
+		return nil, fmt.Errorf("cannot get views of user, %w", queryError(ctx, err))
+	}
+
+	return views, nil
+}
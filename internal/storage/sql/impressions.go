@@ -0,0 +1,104 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ImpressionItem is a shown banner. Clicks refer to it by ID.
+type ImpressionItem struct {
+	ID           string       `db:"id"`
+	SlotID       string       `db:"slot_id"`
+	BannerID     string       `db:"banner_id"`
+	SocialDemoID string       `db:"social_demo_id"`
+	UserID       string       `db:"user_id"`
+	CreatedAt    time.Time    `db:"created_at"`
+	ClickedAt    sql.NullTime `db:"clicked_at"`
+}
+
+var ErrAlreadyClicked = errors.New("impression was already clicked")
+
+// AddImpression records a banner shown to the user, who may be anonymous. Unlike
+// view events it is written synchronously, so a click can refer to it right away
+// and frequency caps count it.
+func (s *Storage) AddImpression(ctx context.Context, id string, bannerID string, slotID string, socialDemoID string, userID string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).ExecContext(ctx, "INSERT INTO impressions (id,slot_id,banner_id,social_demo_id,user_id) VALUES ($1,$2,$3,$4,$5)",
+		id, slotID, bannerID, socialDemoID, userID)
+	if err != nil {
+		return fmt.Errorf("cannot insert impression, %w", queryError(ctx, err))
+	}
+
+	return nil
+}
+
+// ClickImpression marks the impression as clicked at clickedAt and stores the
+// time passed since it was shown. An impression is clicked once at most.
+func (s *Storage) ClickImpression(ctx context.Context, id string, clickedAt time.Time) (impression ImpressionItem, err error) {
+	err = s.WithTx(ctx, func(ctx context.Context) error {
+		impression, err = s.getImpression(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if impression.ClickedAt.Valid {
+			return fmt.Errorf("cannot click impression %s, %w", id, ErrAlreadyClicked)
+		}
+
+		latency := clickedAt.Sub(impression.CreatedAt)
+		if latency < 0 {
+			latency = 0
+		}
+
+		ctx, cancel := s.withTimeout(ctx)
+		defer cancel()
+
+		result, err := s.querier(ctx).ExecContext(ctx,
+			"UPDATE impressions SET clicked_at=$1,click_latency_ms=$2 WHERE id=$3 AND clicked_at IS NULL",
+			s.timeArg(clickedAt), latency.Milliseconds(), id)
+		if err != nil {
+			return fmt.Errorf("cannot click impression %s, %w", id, queryError(ctx, err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("cannot get affected rows count, %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("cannot click impression %s, %w", id, ErrAlreadyClicked)
+		}
+
+		impression.ClickedAt = sql.NullTime{Time: clickedAt, Valid: true}
+
+		return nil
+	})
+
+	return impression, err
+}
+
+func (s *Storage) getImpression(ctx context.Context, id string) (impression ImpressionItem, err error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	query := "SELECT id,slot_id,banner_id,social_demo_id,user_id,created_at,clicked_at FROM impressions WHERE id=$1"
+	if s.isPostgres() {
+		query += " FOR UPDATE"
+	}
+
+	err = s.querier(ctx).GetContext(ctx, &impression, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return impression, fmt.Errorf("cannot get impression %s, %w", id, ErrNotFound)
+	}
+
+	if err != nil {
+		return impression, fmt.Errorf("cannot get impression %s, %w", id, queryError(ctx, err))
+	}
+
+	return impression, nil
+}
@@ -0,0 +1,100 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/VladimirButakov/otus-project/internal/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	targetPrimary = "primary"
+	targetReplica = "replica"
+)
+
+var tracer = otel.Tracer("github.com/VladimirButakov/otus-project/internal/storage/sql")
+
+// instrumentedQuerier traces every query of the wrapped querier and observes its latency.
+type instrumentedQuerier struct {
+	querier
+	target string
+}
+
+func (q instrumentedQuerier) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, end := q.start(ctx, query)
+
+	result, err := q.querier.ExecContext(ctx, query, args...)
+	end(err)
+
+	return result, err
+}
+
+func (q instrumentedQuerier) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	ctx, end := q.start(ctx, query)
+
+	err := q.querier.SelectContext(ctx, dest, query, args...)
+	end(err)
+
+	return err
+}
+
+func (q instrumentedQuerier) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	ctx, end := q.start(ctx, query)
+
+	err := q.querier.GetContext(ctx, dest, query, args...)
+	end(err)
+
+	return err
+}
+
+func (q instrumentedQuerier) NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error) {
+	ctx, end := q.start(ctx, query)
+
+	result, err := q.querier.NamedExecContext(ctx, query, arg)
+	end(err)
+
+	return result, err
+}
+
+// start starts the span of the query and returns a function which ends it with the
+// error of the query and observes the latency. Missing rows are not an error here.
+func (q instrumentedQuerier) start(ctx context.Context, query string) (context.Context, func(err error)) {
+	start := time.Now()
+	operation := statement(query)
+
+	ctx, span := tracer.Start(ctx, "sqlstorage "+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.DBOperationKey.String(operation),
+		semconv.DBStatementKey.String(query),
+		attribute.String("db.target", q.target),
+	))
+
+	return ctx, func(err error) {
+		metrics.DBQueryDuration.WithLabelValues(operation, q.target).Observe(time.Since(start).Seconds())
+
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		span.End()
+	}
+}
+
+// statement returns the first keyword of the query, such as select or insert,
+// which keeps the number of label values small.
+func statement(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(fields[0])
+}
@@ -0,0 +1,332 @@
+package sqlstorage
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// Status and SortOrder values match the enums of the api.
+type Status int
+
+const (
+	StatusAny Status = iota
+	// StatusActive matches banners and slots which are in rotation entries active now.
+	StatusActive
+	StatusInactive
+)
+
+type SortOrder int
+
+const (
+	SortByID SortOrder = iota
+	SortByIDDesc
+	SortByCreatedAt
+	SortByCreatedAtDesc
+)
+
+// ListFilter selects a page of a list. Zero values mean no filtering.
+type ListFilter struct {
+	PageSize      int
+	PageToken     string
+	Description   string
+	SlotID        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Status        Status
+	OrderBy       SortOrder
+}
+
+type RotationItem struct {
+	SlotID   string       `db:"slot_id"`
+	BannerID string       `db:"banner_id"`
+	StartsAt sql.NullTime `db:"starts_at"`
+	EndsAt   sql.NullTime `db:"ends_at"`
+	TimeZone string       `db:"time_zone"`
+	FrequencyCap
+	CreatedAt time.Time `db:"created_at"`
+}
+
+var (
+	ErrInvalidPageToken  = errors.New("invalid page token")
+	ErrUnsupportedFilter = errors.New("unsupported filter")
+)
+
+// pageToken is the sort key of the last row of a page. Filter binds it to the
+// filter of the list it was returned by.
+type pageToken struct {
+	OrderBy   SortOrder `json:"o"`
+	Filter    string    `json:"f"`
+	CreatedAt time.Time `json:"c"`
+	Keys      []string  `json:"k"`
+}
+
+func (t pageToken) encode() string {
+	data, _ := json.Marshal(t)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// filterKey is the fingerprint of the table and filter of a list, except page size and token.
+func filterKey(table string, filter ListFilter) string {
+	filter.PageSize, filter.PageToken = 0, ""
+	filter.CreatedAfter, filter.CreatedBefore = filter.CreatedAfter.UTC(), filter.CreatedBefore.UTC()
+
+	data, _ := json.Marshal(filter)
+	sum := sha256.Sum256(append([]byte(table+"\x00"), data...))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+func decodePageToken(token string) (t pageToken, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(data, &t)
+	}
+
+	if err != nil {
+		return t, fmt.Errorf("%w, %s", ErrInvalidPageToken, err)
+	}
+
+	return t, nil
+}
+
+// listQuery is a keyset paginated select. keys are the columns which identify a row.
+type listQuery struct {
+	table   string
+	columns string
+	keys    []string
+	where   []string
+	args    []interface{}
+}
+
+func (q *listQuery) and(cond string, args ...interface{}) {
+	q.where = append(q.where, cond)
+	q.args = append(q.args, args...)
+}
+
+// ListBanners returns a page of banners. Status and slot filters check banners_rotation,
+// and active ones are in rotation entries which are active now.
+func (s *Storage) ListBanners(ctx context.Context, filter ListFilter) (banners []BannerItem, next string, err error) {
+	q := s.entityListQuery("banners", filter)
+	q.columns = bannerColumns
+
+	if filter.SlotID != "" {
+		q.and("EXISTS (SELECT 1 FROM banners_rotation r WHERE r.banner_id=banners.id AND r.slot_id=?)", filter.SlotID)
+	}
+
+	s.statusFilter(&q, filter.Status, "r.banner_id=banners.id")
+
+	next, err = s.list(ctx, &banners, q, filter, func(i int) ([]string, time.Time) {
+		return []string{banners[i].ID}, banners[i].CreatedAt
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return banners, next, nil
+}
+
+func (s *Storage) ListSlots(ctx context.Context, filter ListFilter) (slots []SlotItem, next string, err error) {
+	if filter.SlotID != "" {
+		return nil, "", fmt.Errorf("cannot list slots, slot: %w", ErrUnsupportedFilter)
+	}
+
+	q := s.entityListQuery("slots", filter)
+	s.statusFilter(&q, filter.Status, "r.slot_id=slots.id")
+
+	next, err = s.list(ctx, &slots, q, filter, func(i int) ([]string, time.Time) {
+		return []string{slots[i].ID}, slots[i].CreatedAt
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.loadSlotSizes(ctx, slots); err != nil {
+		return nil, "", err
+	}
+
+	return slots, next, nil
+}
+
+func (s *Storage) ListSocialDemos(ctx context.Context, filter ListFilter) (socialDemos []SocialDemoItem, next string, err error) {
+	if filter.SlotID != "" || filter.Status != StatusAny {
+		return nil, "", fmt.Errorf("cannot list social demos, slot or status: %w", ErrUnsupportedFilter)
+	}
+
+	next, err = s.list(ctx, &socialDemos, s.entityListQuery("social_demos", filter), filter, func(i int) ([]string, time.Time) {
+		return []string{socialDemos[i].ID}, socialDemos[i].CreatedAt
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return socialDemos, next, nil
+}
+
+// ListRotations returns a page of rotation entries, sorted by slot and banner when ordered by id.
+func (s *Storage) ListRotations(ctx context.Context, filter ListFilter) (rotations []RotationItem, next string, err error) {
+	if filter.Description != "" || filter.Status != StatusAny {
+		return nil, "", fmt.Errorf("cannot list rotations, description or status: %w", ErrUnsupportedFilter)
+	}
+
+	q := listQuery{table: "banners_rotation", columns: "slot_id,banner_id,starts_at,ends_at,time_zone,cap_per_hour,cap_per_day,created_at", keys: []string{"slot_id", "banner_id"}}
+	s.createdFilter(&q, filter)
+
+	if filter.SlotID != "" {
+		q.and("slot_id=?", filter.SlotID)
+	}
+
+	next, err = s.list(ctx, &rotations, q, filter, func(i int) ([]string, time.Time) {
+		return []string{rotations[i].SlotID, rotations[i].BannerID}, rotations[i].CreatedAt
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rotations, next, nil
+}
+
+func (s *Storage) entityListQuery(table string, filter ListFilter) listQuery {
+	q := listQuery{table: table, columns: entityColumns, keys: []string{"id"}}
+	s.createdFilter(&q, filter)
+
+	if filter.Description != "" {
+		q.and(`LOWER(description) LIKE ? ESCAPE '\'`, "%"+escapeLike(strings.ToLower(filter.Description))+"%")
+	}
+
+	return q
+}
+
+func (s *Storage) createdFilter(q *listQuery, filter ListFilter) {
+	if !filter.CreatedAfter.IsZero() {
+		q.and("created_at>=?", s.timeArg(filter.CreatedAfter))
+	}
+
+	if !filter.CreatedBefore.IsZero() {
+		q.and("created_at<?", s.timeArg(filter.CreatedBefore))
+	}
+}
+
+// statusFilter selects entities with or without rotation entries r matching rotation
+// which are active now, by the same condition as banner selection.
+func (s *Storage) statusFilter(q *listQuery, status Status, rotation string) {
+	activeCond, activeArgs := s.activeRotation("r.", time.Now())
+	active := "EXISTS (SELECT 1 FROM banners_rotation r WHERE " + rotation + " AND " + activeCond + ")"
+
+	switch status {
+	case StatusActive:
+		q.and(active, activeArgs...)
+	case StatusInactive:
+		q.and("NOT "+active, activeArgs...)
+	case StatusAny:
+	}
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// timeArg converts t to a query argument comparable with created_at columns.
+func (s *Storage) timeArg(t time.Time) interface{} {
+	if !s.isPostgres() {
+		// SQLite keeps CURRENT_TIMESTAMP as UTC text, so compare with the same format.
+		return t.UTC().Format("2006-01-02 15:04:05")
+	}
+
+	return t
+}
+
+// list selects a page of q into dest, a pointer to a slice, using the sort key of
+// the page token instead of an offset. key returns the sort key of the i-th row.
+func (s *Storage) list(
+	ctx context.Context,
+	dest interface{},
+	q listQuery,
+	filter ListFilter,
+	key func(i int) ([]string, time.Time),
+) (string, error) {
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	sortColumns := q.keys
+	if filter.OrderBy == SortByCreatedAt || filter.OrderBy == SortByCreatedAtDesc {
+		sortColumns = append([]string{"created_at"}, q.keys...)
+	}
+
+	direction, cmp := "ASC", ">"
+	if filter.OrderBy == SortByIDDesc || filter.OrderBy == SortByCreatedAtDesc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if filter.PageToken != "" {
+		token, err := decodePageToken(filter.PageToken)
+		if err != nil {
+			return "", err
+		}
+
+		if token.OrderBy != filter.OrderBy || len(token.Keys) != len(q.keys) {
+			return "", fmt.Errorf("%w, sort order does not match", ErrInvalidPageToken)
+		}
+
+		if token.Filter != filterKey(q.table, filter) {
+			return "", fmt.Errorf("%w, filter does not match", ErrInvalidPageToken)
+		}
+
+		args := make([]interface{}, 0, len(sortColumns))
+		if len(sortColumns) > len(q.keys) {
+			args = append(args, s.timeArg(token.CreatedAt))
+		}
+
+		for _, k := range token.Keys {
+			args = append(args, k)
+		}
+
+		q.and(fmt.Sprintf("(%s) %s (%s)", strings.Join(sortColumns, ","), cmp,
+			strings.TrimSuffix(strings.Repeat("?,", len(args)), ",")), args...)
+	}
+
+	query := "SELECT " + q.columns + " FROM " + q.table
+	if len(q.where) > 0 {
+		query += " WHERE " + strings.Join(q.where, " AND ")
+	}
+
+	query += " ORDER BY " + strings.Join(sortColumns, " "+direction+",") + " " + direction
+	query += fmt.Sprintf(" LIMIT %d", pageSize+1)
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if err := s.selectRead(ctx, dest, s.db.Rebind(query), q.args...); err != nil {
+		return "", fmt.Errorf("cannot list %s, %w", q.table, queryError(ctx, err))
+	}
+
+	// One extra row is selected to know whether there is a next page.
+	rows := reflect.ValueOf(dest).Elem()
+	if rows.Len() <= pageSize {
+		return "", nil
+	}
+
+	rows.Set(rows.Slice(0, pageSize))
+	keys, createdAt := key(pageSize - 1)
+
+	return pageToken{OrderBy: filter.OrderBy, Filter: filterKey(q.table, filter), CreatedAt: createdAt, Keys: keys}.encode(), nil
+}
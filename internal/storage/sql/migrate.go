@@ -0,0 +1,134 @@
+package sqlstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// migrationsLock is the postgres advisory lock key which serializes migrations of
+// instances started at once.
+const migrationsLock = 7210442
+
+var ErrInvalidMigration = errors.New("migration file name must be NNNN_name.sql")
+
+type migration struct {
+	version int
+	name    string
+}
+
+// Migrate applies the migrations of fsys which are not applied yet, in the order of
+// their versions, in a single transaction. Applied versions are recorded in
+// schema_migrations.
+func (s *Storage) Migrate(ctx context.Context, fsys fs.FS) error {
+	migrations, err := readMigrations(fsys)
+	if err != nil {
+		return err
+	}
+
+	// Migrations are not limited by the query timeout, as they may copy whole tables.
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin migrations, %w", queryError(ctx, err))
+	}
+
+	if err := s.applyMigrations(ctx, tx, fsys, migrations); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("cannot rollback migrations, %s, %w", rbErr, err)
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("cannot commit migrations, %w", queryError(ctx, err))
+	}
+
+	return nil
+}
+
+func (s *Storage) applyMigrations(ctx context.Context, tx *sqlx.Tx, fsys fs.FS, migrations []migration) error {
+	if s.isPostgres() {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationsLock); err != nil {
+			return fmt.Errorf("cannot lock migrations, %w", queryError(ctx, err))
+		}
+	}
+
+	_, err := tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER NOT NULL PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return fmt.Errorf("cannot create schema migrations, %w", queryError(ctx, err))
+	}
+
+	var applied []int
+	if err := tx.SelectContext(ctx, &applied, "SELECT version FROM schema_migrations"); err != nil {
+		return fmt.Errorf("cannot get applied migrations, %w", queryError(ctx, err))
+	}
+
+	done := make(map[int]bool, len(applied))
+	for _, version := range applied {
+		done[version] = true
+	}
+
+	for _, m := range migrations {
+		if done[m.version] {
+			continue
+		}
+
+		script, err := fs.ReadFile(fsys, m.name)
+		if err != nil {
+			return fmt.Errorf("cannot read migration %s, %w", m.name, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+			return fmt.Errorf("cannot apply migration %s, %w", m.name, queryError(ctx, err))
+		}
+
+		_, err = tx.ExecContext(ctx, tx.Rebind("INSERT INTO schema_migrations (version,name) VALUES (?,?)"), m.version, m.name)
+		if err != nil {
+			return fmt.Errorf("cannot record migration %s, %w", m.name, queryError(ctx, err))
+		}
+	}
+
+	return nil
+}
+
+func readMigrations(fsys fs.FS) ([]migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("cannot list migrations, %w", err)
+	}
+
+	migrations := make([]migration, 0, len(names))
+	versions := make(map[int]string, len(names))
+
+	for _, name := range names {
+		prefix, _, ok := strings.Cut(path.Base(name), "_")
+
+		version, err := strconv.Atoi(prefix)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("cannot read migration %s, %w", name, ErrInvalidMigration)
+		}
+
+		if other, ok := versions[version]; ok {
+			return nil, fmt.Errorf("cannot read migration %s, same version as %s, %w", name, other, ErrInvalidMigration)
+		}
+
+		versions[version] = name
+		migrations = append(migrations, migration{version: version, name: name})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+
+	return migrations, nil
+}
@@ -0,0 +1,121 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type replica struct {
+	db      *sqlx.DB
+	healthy atomic.Bool
+}
+
+// ConnectReplicas opens read replicas used for statistics and listing queries.
+// Unreachable replicas are kept and marked unhealthy until a health check succeeds.
+func (s *Storage) ConnectReplicas(ctx context.Context, connectionStrings []string) error {
+	for _, connectionString := range connectionStrings {
+		db, err := sqlx.Open(s.db.DriverName(), connectionString)
+		if err != nil {
+			return fmt.Errorf("cannot open replica db, %w", err)
+		}
+
+		r := &replica{db: db}
+		r.healthy.Store(db.PingContext(ctx) == nil)
+
+		s.replicas = append(s.replicas, r)
+	}
+
+	return nil
+}
+
+// RunReplicaHealthChecks pings replicas on every interval until ctx is done.
+func (s *Storage) RunReplicaHealthChecks(ctx context.Context, interval time.Duration) {
+	if len(s.replicas) == 0 || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, r := range s.replicas {
+			pingCtx, cancel := s.withTimeout(ctx)
+			r.healthy.Store(r.db.PingContext(pingCtx) == nil)
+			cancel()
+		}
+	}
+}
+
+// reader returns the next healthy replica, or nil when queries should go to the primary.
+func (s *Storage) reader(ctx context.Context) *replica {
+	if inTx(ctx) {
+		return nil
+	}
+
+	for range s.replicas {
+		next := atomic.AddUint32(&s.nextReplica, 1)
+		r := s.replicas[int(next)%len(s.replicas)]
+
+		if r.healthy.Load() {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// selectRead runs a read-only query on a replica. When the replica cannot be
+// reached, it is marked unhealthy and the query falls back to the primary; other
+// errors, such as invalid queries or statement timeouts, are returned as they are.
+// It is meant for statistics and list queries only: banner selection reads the
+// primary, as replicas lag behind.
+func (s *Storage) selectRead(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	if r := s.reader(ctx); r != nil {
+		err := instrumentedQuerier{r.db, targetReplica}.SelectContext(ctx, dest, query, args...)
+		if err == nil || ctx.Err() != nil || !isConnectionError(err) {
+			return err
+		}
+
+		r.healthy.Store(false)
+
+		// Drop rows the replica may have scanned before failing.
+		v := reflect.ValueOf(dest).Elem()
+		v.Set(reflect.Zero(v.Type()))
+	}
+
+	return s.querier(ctx).SelectContext(ctx, dest, query, args...)
+}
+
+// isConnectionError reports an error of the connection to the database rather
+// than of the query: a broken or refused connection or a postgres connection
+// exception, class 08.
+func isConnectionError(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code.Class() == "08"
+}
@@ -0,0 +1,206 @@
+package sqlstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// partitionLayout is the date suffix of daily event partitions, e.g. views_p20221027.
+const partitionLayout = "20060102"
+
+// eventTables are the event tables covered by retention, each rolled up into
+// the event_counters column of the same name.
+var eventTables = []string{"views", "clicks"}
+
+func partitionName(table string, day time.Time) string {
+	return table + "_p" + day.Format(partitionLayout)
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// CreateEventPartitions creates daily partitions of the event tables for the given
+// number of days starting from the day of from. It is a no-op for backends
+// without partitioning.
+func (s *Storage) CreateEventPartitions(ctx context.Context, from time.Time, days int) error {
+	if !s.isPostgres() {
+		return nil
+	}
+
+	for _, table := range eventTables {
+		for i := 0; i < days; i++ {
+			day := truncateDay(from).AddDate(0, 0, i)
+
+			query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%s) TO (%s)",
+				pq.QuoteIdentifier(partitionName(table, day)),
+				pq.QuoteIdentifier(table),
+				pq.QuoteLiteral(day.Format(time.RFC3339)),
+				pq.QuoteLiteral(day.AddDate(0, 0, 1).Format(time.RFC3339)),
+			)
+
+			err := s.exec(ctx, query)
+			if err != nil && !isCheckViolation(err) {
+				return fmt.Errorf("cannot create partition of %s, %w", table, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isCheckViolation reports a partition which cannot be created because the default
+// partition already has rows for its range. Such rows are purged row by row.
+func isCheckViolation(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == "23514"
+}
+
+// PurgeEvents removes events created before the given time. Their counts are
+// added to event_counters first, so the bandit keeps what it has learned.
+// Whole daily partitions are dropped, or detached and kept as archive tables
+// when archive is set. Remaining old rows are moved out row by row. Old
+// impressions are deleted, so they cannot be clicked anymore.
+// It returns the number of purged partitions.
+func (s *Storage) PurgeEvents(ctx context.Context, before time.Time, archive bool) (int, error) {
+	purged := 0
+
+	for _, table := range eventTables {
+		source := table
+
+		if s.isPostgres() {
+			partitions, err := s.getExpiredPartitions(ctx, table, before)
+			if err != nil {
+				return purged, err
+			}
+
+			for _, partition := range partitions {
+				if err := s.purgePartition(ctx, table, partition, archive); err != nil {
+					return purged, err
+				}
+
+				purged++
+			}
+
+			source = table + "_default"
+		}
+
+		if err := s.purgeRows(ctx, table, source, before, archive); err != nil {
+			return purged, err
+		}
+	}
+
+	if err := s.exec(ctx, "DELETE FROM impressions WHERE created_at < $1", s.timeArg(before)); err != nil {
+		return purged, fmt.Errorf("cannot delete old impressions, %w", err)
+	}
+
+	return purged, nil
+}
+
+func (s *Storage) getExpiredPartitions(ctx context.Context, table string, before time.Time) ([]string, error) {
+	var partitions []string
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	err := s.querier(ctx).SelectContext(ctx, &partitions, `SELECT c.relname FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		JOIN pg_class p ON p.oid = i.inhparent
+		WHERE p.relname = $1`, table)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get partitions of %s, %w", table, queryError(ctx, err))
+	}
+
+	expired := partitions[:0]
+
+	for _, partition := range partitions {
+		day, err := time.Parse(partitionLayout, strings.TrimPrefix(partition, table+"_p"))
+		if err != nil {
+			continue
+		}
+
+		if !day.AddDate(0, 0, 1).After(before) {
+			expired = append(expired, partition)
+		}
+	}
+
+	return expired, nil
+}
+
+func (s *Storage) purgePartition(ctx context.Context, table string, partition string, archive bool) error {
+	return s.WithTx(ctx, func(ctx context.Context) error {
+		err := s.exec(ctx, fmt.Sprintf(`INSERT INTO event_counters (slot_id,banner_id,social_demo_id,%[1]s)
+			SELECT slot_id,banner_id,social_demo_id,COUNT(*) FROM %[2]s GROUP BY slot_id,banner_id,social_demo_id
+			ON CONFLICT (slot_id,banner_id,social_demo_id) DO UPDATE SET %[1]s = event_counters.%[1]s + EXCLUDED.%[1]s`,
+			table, pq.QuoteIdentifier(partition)))
+		if err != nil {
+			return fmt.Errorf("cannot roll up partition %s, %w", partition, err)
+		}
+
+		if archive {
+			err = s.exec(ctx, fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", pq.QuoteIdentifier(table), pq.QuoteIdentifier(partition)))
+			if err == nil {
+				err = s.exec(ctx, fmt.Sprintf("ALTER TABLE %s RENAME TO %s",
+					pq.QuoteIdentifier(partition), pq.QuoteIdentifier(strings.Replace(partition, "_p", "_archive_", 1))))
+			}
+		} else {
+			err = s.exec(ctx, "DROP TABLE "+pq.QuoteIdentifier(partition))
+		}
+
+		if err != nil {
+			return fmt.Errorf("cannot purge partition %s, %w", partition, err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Storage) purgeRows(ctx context.Context, table string, source string, before time.Time, archive bool) error {
+	beforeArg := s.timeArg(before)
+
+	return s.WithTx(ctx, func(ctx context.Context) error {
+		err := s.exec(ctx, fmt.Sprintf(`INSERT INTO event_counters (slot_id,banner_id,social_demo_id,%[1]s)
+			SELECT slot_id,banner_id,social_demo_id,COUNT(*) FROM %[2]s WHERE created_at < $1 GROUP BY slot_id,banner_id,social_demo_id
+			ON CONFLICT (slot_id,banner_id,social_demo_id) DO UPDATE SET %[1]s = event_counters.%[1]s + EXCLUDED.%[1]s`,
+			table, source), beforeArg)
+		if err != nil {
+			return fmt.Errorf("cannot roll up %s, %w", source, err)
+		}
+
+		if archive {
+			err = s.exec(ctx, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %[1]s_archive AS SELECT * FROM %[1]s WHERE 1=0", table))
+			if err == nil {
+				err = s.exec(ctx, fmt.Sprintf("INSERT INTO %s_archive SELECT * FROM %s WHERE created_at < $1", table, source), beforeArg)
+			}
+
+			if err != nil {
+				return fmt.Errorf("cannot archive %s, %w", source, err)
+			}
+		}
+
+		if err := s.exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE created_at < $1", source), beforeArg); err != nil {
+			return fmt.Errorf("cannot delete old rows from %s, %w", source, err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Storage) exec(ctx context.Context, query string, args ...interface{}) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := s.querier(ctx).ExecContext(ctx, query, args...); err != nil {
+		return queryError(ctx, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package sqlstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Schedule is the time range in which a rotation entry is active. A zero StartsAt
+// or EndsAt leaves the range open. TimeZone is the IANA zone the range was planned in.
+type Schedule struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+	TimeZone string
+}
+
+// ScheduleBannerRotation adds a banner to the slot, or changes the schedule of a
+// banner already in rotation. The entry is active from StartsAt until EndsAt.
+func (s *Storage) ScheduleBannerRotation(ctx context.Context, bannerID string, slotID string, schedule Schedule) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).ExecContext(ctx, `INSERT INTO banners_rotation (slot_id,banner_id,starts_at,ends_at,time_zone)
+		VALUES ($1,$2,$3,$4,$5)
+		ON CONFLICT (slot_id,banner_id) DO UPDATE SET starts_at=EXCLUDED.starts_at,ends_at=EXCLUDED.ends_at,time_zone=EXCLUDED.time_zone`,
+		slotID, bannerID, s.nullTimeArg(schedule.StartsAt), s.nullTimeArg(schedule.EndsAt), schedule.TimeZone)
+	if err != nil {
+		return fmt.Errorf("cannot schedule banner rotation, %w", queryError(ctx, err))
+	}
+
+	return nil
+}
+
+// activeRotation is the condition on the banners_rotation columns with the given
+// prefix which selects entries active at now, and its arguments.
+func (s *Storage) activeRotation(prefix string, now time.Time) (string, []interface{}) {
+	nowArg := s.timeArg(now)
+
+	return fmt.Sprintf("(%[1]sstarts_at IS NULL OR %[1]sstarts_at<=?) AND (%[1]sends_at IS NULL OR %[1]sends_at>?)", prefix),
+		[]interface{}{nowArg, nowArg}
+}
+
+func (s *Storage) nullTimeArg(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+
+	return s.timeArg(t)
+}
@@ -0,0 +1,146 @@
+package sqlstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StatsGrouping values match the enum of the api.
+type StatsGrouping int
+
+const (
+	GroupByNone StatsGrouping = iota
+	GroupByHour
+	GroupByDay
+)
+
+// StatsFilter selects the events counted by GetStats. Zero values mean no filtering.
+type StatsFilter struct {
+	SlotID       string
+	SocialDemoID string
+	From         time.Time
+	To           time.Time
+	GroupBy      StatsGrouping
+}
+
+// StatsItem holds the events of a banner, in a period when grouped.
+type StatsItem struct {
+	BannerID string
+	Period   time.Time
+	Views    int
+	Clicks   int
+}
+
+type statsRow struct {
+	BannerID string `db:"banner_id"`
+	Period   string `db:"period"`
+	Views    int    `db:"views"`
+	Clicks   int    `db:"clicks"`
+}
+
+// GetStats counts views and clicks per banner. Events rolled up by the retention
+// job have no time, so they are counted only without time range and grouping.
+func (s *Storage) GetStats(ctx context.Context, filter StatsFilter) ([]StatsItem, error) {
+	var (
+		where []string
+		args  []interface{}
+	)
+
+	if filter.SlotID != "" {
+		where = append(where, "slot_id=?")
+		args = append(args, filter.SlotID)
+	}
+
+	if filter.SocialDemoID != "" {
+		where = append(where, "social_demo_id=?")
+		args = append(args, filter.SocialDemoID)
+	}
+
+	counterWhere, counterArgs := where, args
+
+	if !filter.From.IsZero() {
+		where = append(where, "created_at>=?")
+		args = append(args, s.timeArg(filter.From))
+	}
+
+	if !filter.To.IsZero() {
+		where = append(where, "created_at<?")
+		args = append(args, s.timeArg(filter.To))
+	}
+
+	period := s.periodExpr(filter.GroupBy)
+	events := func(table string, columns string) string {
+		query := "SELECT banner_id," + period + " AS period," + columns + " FROM " + table
+		if len(where) > 0 {
+			query += " WHERE " + strings.Join(where, " AND ")
+		}
+
+		return query + " GROUP BY banner_id,period"
+	}
+
+	query := events("views", "COUNT(*) AS views,0 AS clicks") + " UNION ALL " + events("clicks", "0 AS views,COUNT(*) AS clicks")
+	queryArgs := append(append([]interface{}{}, args...), args...)
+
+	if filter.From.IsZero() && filter.To.IsZero() && filter.GroupBy == GroupByNone {
+		query += " UNION ALL SELECT banner_id,'' AS period,views,clicks FROM event_counters"
+		if len(counterWhere) > 0 {
+			query += " WHERE " + strings.Join(counterWhere, " AND ")
+		}
+
+		queryArgs = append(queryArgs, counterArgs...)
+	}
+
+	query = "SELECT banner_id,period,SUM(views) AS views,SUM(clicks) AS clicks FROM (" + query +
+		") events GROUP BY banner_id,period ORDER BY period,banner_id"
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	var rows []statsRow
+
+	if err := s.selectRead(ctx, &rows, s.db.Rebind(query), queryArgs...); err != nil {
+		return nil, fmt.Errorf("cannot get stats, %w", queryError(ctx, err))
+	}
+
+	stats := make([]StatsItem, 0, len(rows))
+
+	for _, row := range rows {
+		item := StatsItem{BannerID: row.BannerID, Views: row.Views, Clicks: row.Clicks}
+
+		if row.Period != "" {
+			p, err := time.Parse(time.RFC3339, row.Period)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse stats period, %w", err)
+			}
+
+			item.Period = p
+		}
+
+		stats = append(stats, item)
+	}
+
+	return stats, nil
+}
+
+// periodExpr returns the start of the period of an event as RFC 3339 text, or
+// an empty string without grouping.
+func (s *Storage) periodExpr(groupBy StatsGrouping) string {
+	unit, format := "", ""
+
+	switch groupBy {
+	case GroupByHour:
+		unit, format = "hour", "%Y-%m-%dT%H:00:00Z"
+	case GroupByDay:
+		unit, format = "day", "%Y-%m-%dT00:00:00Z"
+	default:
+		return "''"
+	}
+
+	if s.isPostgres() {
+		return "to_char(date_trunc('" + unit + "',created_at AT TIME ZONE 'UTC'),'YYYY-MM-DD\"T\"HH24:MI:SS\"Z\"')"
+	}
+
+	return "strftime('" + format + "',created_at)"
+}
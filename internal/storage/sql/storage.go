@@ -4,17 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 )
 
 type Storage struct {
-	db *sqlx.DB
+	db           *sqlx.DB
+	replicas     []*replica
+	nextReplica  uint32
+	queryTimeout time.Duration
 }
 
+// BannerRotationItem is a banner in rotation of a slot with its frequency cap.
 type BannerRotationItem struct {
 	SlotID   string `db:"slot_id"`
 	BannerID string `db:"banner_id"`
+	FrequencyCap
 }
 
 type ClickItem struct {
@@ -31,6 +37,14 @@ type ViewItem struct {
 	Date         string `db:"date"`
 }
 
+// CounterItem holds view and click counts of a banner, such as the ones rolled up by the retention job.
+type CounterItem struct {
+	SlotID   string `db:"slot_id"`
+	BannerID string `db:"banner_id"`
+	Views    int    `db:"views"`
+	Clicks   int    `db:"clicks"`
+}
+
 type NotViewedItem struct {
 	SlotID   string `db:"slot_id"`
 	BannerID string `db:"banner_id"`
@@ -38,13 +52,17 @@ type NotViewedItem struct {
 
 var ErrBannersWereRemoved = errors.New("banners were not removed from rotation")
 
-func New(ctx context.Context, connectionString string) (*Storage, error) {
+func New(ctx context.Context, connectionString string, queryTimeout time.Duration) (*Storage, error) {
 	db, err := sqlx.ConnectContext(ctx, "postgres", connectionString)
 	if err != nil {
 		return nil, fmt.Errorf("cannot open db, %w", err)
 	}
 
-	return &Storage{db}, nil
+	return &Storage{db: db, queryTimeout: queryTimeout}, nil
+}
+
+func NewWithDB(db *sqlx.DB, queryTimeout time.Duration) *Storage {
+	return &Storage{db: db, queryTimeout: queryTimeout}
 }
 
 func (s *Storage) Connect(ctx context.Context) error {
@@ -56,22 +74,59 @@ func (s *Storage) Connect(ctx context.Context) error {
 }
 
 func (s *Storage) Close() error {
+	for _, r := range s.replicas {
+		r.db.Close()
+	}
+
 	return s.db.Close()
 }
 
-func (s *Storage) AddBannerRotation(bannerID string, slotID string) error {
-	_, err := s.db.Exec("INSERT INTO banners_rotation (slot_id,banner_id) VALUES ($1,$2)", slotID, bannerID)
+func (s *Storage) isPostgres() bool {
+	return s.db.DriverName() == "postgres"
+}
+
+// withTimeout bounds a single query by the configured timeout on top of the caller deadline.
+func (s *Storage) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, s.queryTimeout)
+}
+
+// queryError prefers the context error, so callers can tell timeouts and cancellations from query failures.
+// Constraint violations are reported as ErrAlreadyExists or ErrForeignKeyViolation.
+func queryError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return fmt.Errorf("%w: %s", ctxErr, err)
+	}
+
+	if domainErr := constraintError(err); domainErr != nil {
+		return fmt.Errorf("%w: %s", domainErr, err)
+	}
+
+	return err
+}
+
+func (s *Storage) AddBannerRotation(ctx context.Context, bannerID string, slotID string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).ExecContext(ctx, "INSERT INTO banners_rotation (slot_id,banner_id) VALUES ($1,$2)", slotID, bannerID)
 	if err != nil {
-		return fmt.Errorf("cannot insert banner to rotation, %w", err)
+		return fmt.Errorf("cannot insert banner to rotation, %w", queryError(ctx, err))
 	}
 
 	return nil
 }
 
-func (s *Storage) RemoveBannerRotation(bannerID string, slotID string) error {
-	result, err := s.db.Exec("DELETE FROM banners_rotation WHERE slot_id=$1 AND banner_id=$2", slotID, bannerID)
+func (s *Storage) RemoveBannerRotation(ctx context.Context, bannerID string, slotID string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	result, err := s.querier(ctx).ExecContext(ctx, "DELETE FROM banners_rotation WHERE slot_id=$1 AND banner_id=$2", slotID, bannerID)
 	if err != nil {
-		return fmt.Errorf("cannot delete banner from rotation, %w", err)
+		return fmt.Errorf("cannot delete banner from rotation, %w", queryError(ctx, err))
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -86,82 +141,207 @@ func (s *Storage) RemoveBannerRotation(bannerID string, slotID string) error {
 	return nil
 }
 
-func (s *Storage) AddClickEvent(bannerID string, slotID string, socialDemoID string, date string) error {
-	_, err := s.db.Exec("INSERT INTO clicks (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
+func (s *Storage) AddClickEvent(ctx context.Context, bannerID string, slotID string, socialDemoID string, date string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).ExecContext(ctx, "INSERT INTO clicks (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
 	if err != nil {
-		return fmt.Errorf("cannot insert banner click, %w", err)
+		return fmt.Errorf("cannot insert banner click, %w", queryError(ctx, err))
 	}
 
 	return nil
 }
 
-func (s *Storage) AddViewEvent(bannerID string, slotID string, socialDemoID string, date string) error {
-	_, err := s.db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
+func (s *Storage) AddViewEvent(ctx context.Context, bannerID string, slotID string, socialDemoID string, date string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).ExecContext(ctx, "INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
 	if err != nil {
-		return fmt.Errorf("cannot insert banner view, %w", err)
+		return fmt.Errorf("cannot insert banner view, %w", queryError(ctx, err))
+	}
+
+	return nil
+}
+
+// eventsBatchSize keeps multi-row inserts below the bind parameter limits of the drivers.
+const eventsBatchSize = 500
+
+// AddViewEvents inserts views with multi-row inserts.
+func (s *Storage) AddViewEvents(ctx context.Context, views []ViewItem) error {
+	for start := 0; start < len(views); start += eventsBatchSize {
+		end := start + eventsBatchSize
+		if end > len(views) {
+			end = len(views)
+		}
+
+		if err := s.addEvents(ctx, "views", views[start:end]); err != nil {
+			return fmt.Errorf("cannot insert banner views, %w", err)
+		}
 	}
 
 	return nil
 }
 
-func (s *Storage) GetNotViewedBanners(slotID string) (notViewedBanners []NotViewedItem, err error) {
-	err = s.db.Select(&notViewedBanners, "SELECT slot_id,banner_id FROM banners_rotation WHERE slot_id=$1 EXCEPT SELECT slot_id,banner_id FROM views", slotID)
+// AddClickEvents inserts clicks with multi-row inserts.
+func (s *Storage) AddClickEvents(ctx context.Context, clicks []ClickItem) error {
+	for start := 0; start < len(clicks); start += eventsBatchSize {
+		end := start + eventsBatchSize
+		if end > len(clicks) {
+			end = len(clicks)
+		}
+
+		if err := s.addEvents(ctx, "clicks", clicks[start:end]); err != nil {
+			return fmt.Errorf("cannot insert banner clicks, %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) addEvents(ctx context.Context, table string, events interface{}) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).NamedExecContext(ctx, "INSERT INTO "+table+` (slot_id,banner_id,social_demo_id,date)
+		VALUES (:slot_id,:banner_id,:social_demo_id,:date)`, events)
 	if err != nil {
-		return nil, fmt.Errorf("cannot get not viewed banners, %w", err)
+		return queryError(ctx, err)
 	}
 
-	return notViewedBanners, nil
+	return nil
 }
 
-func (s *Storage) GetBannersInSlot(slotID string) (bannersInSlot []BannerRotationItem, err error) {
-	err = s.db.Select(&bannersInSlot, "SELECT * FROM banners_rotation WHERE slot_id=$1", slotID)
+// GetNotViewedBanners returns banners of the slot which were never shown to the social demo group.
+func (s *Storage) GetNotViewedBanners(ctx context.Context, slotID string, socialDemoID string) ([]NotViewedItem, error) {
+	return s.GetNotViewedBannersInSlots(ctx, []string{slotID}, socialDemoID)
+}
+
+// GetNotViewedBannersInSlots returns the active banners in rotation of the slots
+// which the social demo group has not viewed there yet.
+func (s *Storage) GetNotViewedBannersInSlots(ctx context.Context, slotIDs []string, socialDemoID string) (notViewedBanners []NotViewedItem, err error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	active, activeArgs := s.activeRotation("r.", time.Now())
+
+	query, args, err := sqlx.In(`SELECT r.slot_id,r.banner_id FROM banners_rotation r
+		WHERE r.slot_id IN (?) AND `+active+`
+		AND NOT EXISTS (SELECT 1 FROM views v
+			WHERE v.slot_id=r.slot_id AND v.social_demo_id=? AND v.banner_id=r.banner_id)
+		AND NOT EXISTS (SELECT 1 FROM event_counters c
+			WHERE c.slot_id=r.slot_id AND c.social_demo_id=? AND c.banner_id=r.banner_id AND c.views > 0)`,
+		slotIDs, activeArgs[0], activeArgs[1], socialDemoID, socialDemoID)
 	if err != nil {
-		return nil, fmt.Errorf("cannot get banners from slot, %w", err)
+		return nil, fmt.Errorf("cannot build not viewed banners query, %w", err)
 	}
 
-	return bannersInSlot, nil
+	err = s.querier(ctx).SelectContext(ctx, &notViewedBanners, s.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get not viewed banners, %w", queryError(ctx, err))
+	}
+
+	return notViewedBanners, nil
+}
+
+func (s *Storage) GetBannersInSlot(ctx context.Context, slotID string) ([]BannerRotationItem, error) {
+	return s.GetBannersInSlots(ctx, []string{slotID})
 }
 
-func (s *Storage) GetBannersClicks(slotID string) (bannersClicks []ClickItem, err error) {
-	err = s.db.Select(&bannersClicks, "SELECT * FROM clicks WHERE slot_id=$1", slotID)
+// GetBannersInSlots returns the banners in rotation of the slots which are active now.
+func (s *Storage) GetBannersInSlots(ctx context.Context, slotIDs []string) (bannersInSlots []BannerRotationItem, err error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	active, activeArgs := s.activeRotation("", time.Now())
+
+	query, args, err := sqlx.In("SELECT slot_id,banner_id,cap_per_hour,cap_per_day FROM banners_rotation WHERE slot_id IN (?) AND "+active,
+		slotIDs, activeArgs[0], activeArgs[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot build banners in slots query, %w", err)
+	}
+
+	// Inside a transaction the rotation rows are locked, so a banner cannot be
+	// removed from the slot while it is being selected and its view recorded.
+	if inTx(ctx) && s.isPostgres() {
+		query += " FOR SHARE"
+	}
+
+	err = s.querier(ctx).SelectContext(ctx, &bannersInSlots, s.db.Rebind(query), args...)
 	if err != nil {
-		return nil, fmt.Errorf("cannot get clicked banners, %w", err)
+		return nil, fmt.Errorf("cannot get banners from slot, %w", queryError(ctx, err))
 	}
 
-	return bannersClicks, nil
+	return bannersInSlots, nil
 }
 
-func (s *Storage) GetBannersViews(slotID string) (bannersViews []ViewItem, err error) {
-	err = s.db.Select(&bannersViews, "SELECT * FROM views WHERE slot_id=$1", slotID)
+// GetEventCountsInSlots returns views and clicks of every banner shown in the slots,
+// including events rolled up by the retention job, counted by the database.
+func (s *Storage) GetEventCountsInSlots(ctx context.Context, slotIDs []string) (counters []CounterItem, err error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	query, args, err := sqlx.In(`SELECT slot_id,banner_id,SUM(views) AS views,SUM(clicks) AS clicks FROM (
+			SELECT slot_id,banner_id,COUNT(*) AS views,0 AS clicks FROM views WHERE slot_id IN (?) GROUP BY slot_id,banner_id
+			UNION ALL
+			SELECT slot_id,banner_id,0 AS views,COUNT(*) AS clicks FROM clicks WHERE slot_id IN (?) GROUP BY slot_id,banner_id
+			UNION ALL
+			SELECT slot_id,banner_id,views,clicks FROM event_counters WHERE slot_id IN (?)
+		) events GROUP BY slot_id,banner_id`, slotIDs, slotIDs, slotIDs)
 	if err != nil {
-		return nil, fmt.Errorf("cannot get viewed banners, %w", err)
+		return nil, fmt.Errorf("cannot build event counts query, %w", err)
 	}
 
-	return bannersViews, nil
+	err = s.querier(ctx).SelectContext(ctx, &counters, s.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get event counts, %w", queryError(ctx, err))
+	}
+
+	return counters, nil
 }
 
-func (s *Storage) CreateBanner(id string, description string) (string, error) {
-	_, err := s.db.Exec("INSERT INTO banners (id,description) VALUES ($1,$2)", id, description)
+func (s *Storage) CreateBanner(ctx context.Context, id string, description string, creative Creative) (string, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).ExecContext(ctx, `INSERT INTO banners (id,description,image_url,landing_url,alt_text,width,height,mime_type)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`, id, description, creative.ImageURL, creative.LandingURL, creative.AltText,
+		creative.Width, creative.Height, creative.MimeType)
 	if err != nil {
-		return "", fmt.Errorf("cannot insert banner, %w", err)
+		return "", fmt.Errorf("cannot insert banner, %w", queryError(ctx, err))
 	}
 
 	return id, nil
 }
 
-func (s *Storage) CreateSlot(id string, description string) (string, error) {
-	_, err := s.db.Exec("INSERT INTO slots (id,description) VALUES ($1,$2)", id, description)
+// CreateSlot creates a slot which accepts banners of the given sizes, or of any size when empty.
+func (s *Storage) CreateSlot(ctx context.Context, id string, description string, sizes []Size) (string, error) {
+	err := s.WithTx(ctx, func(ctx context.Context) error {
+		ctx, cancel := s.withTimeout(ctx)
+		defer cancel()
+
+		_, err := s.querier(ctx).ExecContext(ctx, "INSERT INTO slots (id,description) VALUES ($1,$2)", id, description)
+		if err != nil {
+			return fmt.Errorf("cannot insert slot, %w", queryError(ctx, err))
+		}
+
+		return s.setSlotSizes(ctx, id, sizes)
+	})
 	if err != nil {
-		return "", fmt.Errorf("cannot insert slot, %w", err)
+		return "", err
 	}
 
 	return id, nil
 }
 
-func (s *Storage) CreateSocialDemo(id string, description string) (string, error) {
-	_, err := s.db.Exec("INSERT INTO social_demos (id,description) VALUES ($1,$2)", id, description)
+func (s *Storage) CreateSocialDemo(ctx context.Context, id string, description string) (string, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	_, err := s.querier(ctx).ExecContext(ctx, "INSERT INTO social_demos (id,description) VALUES ($1,$2)", id, description)
 	if err != nil {
-		return "", fmt.Errorf("cannot insert social demo, %w", err)
+		return "", fmt.Errorf("cannot insert social demo, %w", queryError(ctx, err))
 	}
 
 	return id, nil
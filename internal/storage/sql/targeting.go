@@ -0,0 +1,127 @@
+package sqlstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// TargetItem includes or excludes a social demo group for a banner in rotation of a slot.
+type TargetItem struct {
+	SlotID       string `db:"slot_id"`
+	BannerID     string `db:"banner_id"`
+	SocialDemoID string `db:"social_demo_id"`
+	Excluded     bool   `db:"excluded"`
+}
+
+// Targeting restricts a banner in rotation to social demo groups. A banner is
+// shown to the included groups only, or to any group when Include is empty,
+// except the excluded ones.
+type Targeting struct {
+	Include []string
+	Exclude []string
+}
+
+var ErrTargetingConflict = errors.New("social demo is both included and excluded")
+
+// SetTargeting replaces the targeting of a banner in rotation of the slot.
+func (s *Storage) SetTargeting(ctx context.Context, bannerID string, slotID string, targeting Targeting) error {
+	excluded := make(map[string]bool, len(targeting.Exclude))
+	for _, id := range targeting.Exclude {
+		excluded[id] = true
+	}
+
+	for _, id := range targeting.Include {
+		if excluded[id] {
+			return fmt.Errorf("cannot set targeting of social demo %s, %w", id, ErrTargetingConflict)
+		}
+	}
+
+	return s.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.checkRotation(ctx, bannerID, slotID); err != nil {
+			return err
+		}
+
+		if err := s.exec(ctx, "DELETE FROM rotation_targets WHERE slot_id=$1 AND banner_id=$2", slotID, bannerID); err != nil {
+			return fmt.Errorf("cannot delete targeting, %w", err)
+		}
+
+		for _, ids := range []struct {
+			socialDemoIDs []string
+			excluded      bool
+		}{{targeting.Include, false}, {targeting.Exclude, true}} {
+			for _, id := range ids.socialDemoIDs {
+				err := s.exec(ctx, `INSERT INTO rotation_targets (slot_id,banner_id,social_demo_id,excluded) VALUES ($1,$2,$3,$4)
+					ON CONFLICT DO NOTHING`, slotID, bannerID, id, ids.excluded)
+				if err != nil {
+					return fmt.Errorf("cannot insert targeting of social demo %s, %w", id, err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetTargeting returns the targeting of a banner in rotation of the slot.
+func (s *Storage) GetTargeting(ctx context.Context, bannerID string, slotID string) (targeting Targeting, err error) {
+	if err := s.checkRotation(ctx, bannerID, slotID); err != nil {
+		return targeting, err
+	}
+
+	items, err := s.GetTargetingInSlots(ctx, []string{slotID})
+	if err != nil {
+		return targeting, err
+	}
+
+	for _, item := range items {
+		switch {
+		case item.BannerID != bannerID:
+		case item.Excluded:
+			targeting.Exclude = append(targeting.Exclude, item.SocialDemoID)
+		default:
+			targeting.Include = append(targeting.Include, item.SocialDemoID)
+		}
+	}
+
+	return targeting, nil
+}
+
+// GetTargetingInSlots returns the targeting of all banners in rotation of the slots.
+func (s *Storage) GetTargetingInSlots(ctx context.Context, slotIDs []string) (targets []TargetItem, err error) {
+	query, args, err := sqlx.In(`SELECT slot_id,banner_id,social_demo_id,excluded FROM rotation_targets
+		WHERE slot_id IN (?) ORDER BY slot_id,banner_id,social_demo_id`, slotIDs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build targeting query, %w", err)
+	}
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if err := s.querier(ctx).SelectContext(ctx, &targets, s.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("cannot get targeting, %w", queryError(ctx, err))
+	}
+
+	return targets, nil
+}
+
+func (s *Storage) checkRotation(ctx context.Context, bannerID string, slotID string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	var inRotation bool
+
+	err := s.querier(ctx).GetContext(ctx, &inRotation,
+		"SELECT EXISTS (SELECT 1 FROM banners_rotation WHERE slot_id=$1 AND banner_id=$2)", slotID, bannerID)
+	if err != nil {
+		return fmt.Errorf("cannot check rotation, %w", queryError(ctx, err))
+	}
+
+	if !inRotation {
+		return fmt.Errorf("cannot find banner %s in rotation of slot %s, %w", bannerID, slotID, ErrNotFound)
+	}
+
+	return nil
+}
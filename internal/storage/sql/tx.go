@@ -0,0 +1,89 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const txRetries = 3
+
+type txKey struct{}
+
+// querier is implemented by both *sqlx.DB and *sqlx.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+}
+
+// querier returns the transaction bound to ctx by WithTx, or the db itself.
+func (s *Storage) querier(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return instrumentedQuerier{tx, targetPrimary}
+	}
+
+	return instrumentedQuerier{s.db, targetPrimary}
+}
+
+func inTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*sqlx.Tx)
+
+	return ok
+}
+
+// WithTx runs fn in a single repeatable read transaction. Storage calls made with
+// the ctx passed to fn take part in the transaction. Serialization failures are
+// retried, so fn must be safe to run more than once.
+func (s *Storage) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if inTx(ctx) {
+		return fn(ctx)
+	}
+
+	var err error
+
+	for i := 0; i < txRetries; i++ {
+		err = s.runTx(ctx, fn)
+		if !isSerializationFailure(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (s *Storage) runTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead})
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction, %w", queryError(ctx, err))
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("cannot rollback transaction, %s, %w", rbErr, err)
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("cannot commit transaction, %w", queryError(ctx, err))
+	}
+
+	return nil
+}
+
+func isSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+
+	return pqErr.Code == "40001" || pqErr.Code == "40P01"
+}
@@ -0,0 +1,47 @@
+package sqlitestorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	"github.com/VladimirButakov/otus-project/migrations"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+)
+
+const driverName = "sqlite3"
+
+// New opens a SQLite database file, applies pending migrations and returns a storage
+// which runs the same queries as the postgres backend.
+func New(ctx context.Context, path string, queryTimeout time.Duration) (*sqlstorage.Storage, error) {
+	db, err := sqlx.ConnectContext(ctx, driverName, withForeignKeys(path))
+	if err != nil {
+		return nil, fmt.Errorf("cannot open db, %w", err)
+	}
+
+	// SQLite allows a single writer, so all queries share one connection.
+	// It also keeps ":memory:" databases alive between queries.
+	db.SetMaxOpenConns(1)
+
+	storage := sqlstorage.NewWithDB(db, queryTimeout)
+
+	if err := storage.Migrate(ctx, migrations.SQLite); err != nil {
+		db.Close()
+
+		return nil, fmt.Errorf("cannot apply migrations, %w", err)
+	}
+
+	return storage, nil
+}
+
+// withForeignKeys enables foreign key checks, which SQLite turns off by default.
+func withForeignKeys(path string) string {
+	if strings.Contains(path, "?") {
+		return path + "&_foreign_keys=1"
+	}
+
+	return path + "?_foreign_keys=1"
+}
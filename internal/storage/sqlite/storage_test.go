@@ -0,0 +1,442 @@
+package sqlitestorage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	sqlstorage "github.com/VladimirButakov/otus-project/internal/storage/sql"
+	"github.com/VladimirButakov/otus-project/migrations"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	storage, err := New(context.Background(), ":memory:", time.Second)
+	require.NoError(t, err, "should be without errors")
+
+	defer storage.Close()
+
+	ctx := context.Background()
+
+	t.Run("test banner create", func(t *testing.T) {
+		id, err := storage.CreateBanner(ctx, "banner1", "description", sqlstorage.Creative{})
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, "banner1", id)
+
+		_, err = storage.CreateBanner(ctx, "banner1", "description", sqlstorage.Creative{})
+		require.ErrorIs(t, err, sqlstorage.ErrAlreadyExists)
+	})
+
+	t.Run("test add rotation of not existed slot", func(t *testing.T) {
+		err := storage.AddBannerRotation(ctx, "banner1", "slot1")
+		require.ErrorIs(t, err, sqlstorage.ErrForeignKeyViolation)
+
+		for _, id := range []string{"2", "3", "4"} {
+			_, err := storage.CreateBanner(ctx, "banner"+id, "description", sqlstorage.Creative{})
+			require.NoError(t, err, "should be without errors")
+		}
+
+		for _, id := range []string{"1", "2", "3", "4"} {
+			_, err := storage.CreateSlot(ctx, "slot"+id, "description", nil)
+			require.NoError(t, err, "should be without errors")
+		}
+	})
+
+	t.Run("test add and remove banner rotation", func(t *testing.T) {
+		err := storage.AddBannerRotation(ctx, "banner1", "slot1")
+		require.NoError(t, err, "should be without errors")
+
+		err = storage.AddBannerRotation(ctx, "banner1", "slot1")
+		require.ErrorIs(t, err, sqlstorage.ErrAlreadyExists)
+
+		bannersInSlot, err := storage.GetBannersInSlot(ctx, "slot1")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, bannersInSlot, 1)
+		require.Equal(t, "banner1", bannersInSlot[0].BannerID, "bannerID should be same")
+
+		err = storage.RemoveBannerRotation(ctx, "banner1", "slot1")
+		require.NoError(t, err, "should be without errors")
+
+		err = storage.RemoveBannerRotation(ctx, "banner1", "slot1")
+		require.Error(t, err, "second remove should fail")
+	})
+
+	t.Run("test not viewed banners", func(t *testing.T) {
+		err := storage.AddBannerRotation(ctx, "banner2", "slot2")
+		require.NoError(t, err, "should be without errors")
+
+		notViewedBanners, err := storage.GetNotViewedBanners(ctx, "slot2", "social_demo1")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, notViewedBanners, 1)
+
+		err = storage.AddViewEvent(ctx, "banner2", "slot2", "social_demo1", time.Now().String())
+		require.NoError(t, err, "should be without errors")
+
+		notViewedBanners, err = storage.GetNotViewedBanners(ctx, "slot2", "social_demo1")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, notViewedBanners, 0, "should be empty array")
+
+		counts, err := storage.GetEventCountsInSlots(ctx, []string{"slot2"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, counts, 1, "slice should have 1 item")
+		require.Equal(t, 1, counts[0].Views, "view should be counted")
+	})
+
+	t.Run("test not viewed banners per social demo", func(t *testing.T) {
+		notViewedBanners, err := storage.GetNotViewedBanners(ctx, "slot2", "social_demo2")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, notViewedBanners, 1, "banner was not viewed by other social demo")
+		require.Equal(t, "banner2", notViewedBanners[0].BannerID, "bannerID should be same")
+	})
+
+	t.Run("test banner clicks", func(t *testing.T) {
+		err := storage.AddClickEvent(ctx, "banner2", "slot2", "social_demo1", time.Now().String())
+		require.NoError(t, err, "should be without errors")
+
+		stats, err := storage.GetStats(ctx, sqlstorage.StatsFilter{SlotID: "slot2", SocialDemoID: "social_demo1"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, stats, 1, "slice should have 1 item")
+		require.Equal(t, 1, stats[0].Clicks, "click should be counted for the social demo")
+	})
+
+	t.Run("test transaction rollback", func(t *testing.T) {
+		errAbort := errors.New("abort")
+
+		err := storage.WithTx(ctx, func(ctx context.Context) error {
+			err := storage.AddBannerRotation(ctx, "banner3", "slot3")
+			require.NoError(t, err, "should be without errors")
+
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+
+		bannersInSlot, err := storage.GetBannersInSlot(ctx, "slot3")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, bannersInSlot, 0, "insert should be rolled back")
+	})
+
+	t.Run("test transaction commit", func(t *testing.T) {
+		err := storage.WithTx(ctx, func(ctx context.Context) error {
+			if err := storage.AddBannerRotation(ctx, "banner3", "slot3"); err != nil {
+				return err
+			}
+
+			_, err := storage.GetBannersInSlot(ctx, "slot3")
+
+			return err
+		})
+		require.NoError(t, err, "should be without errors")
+
+		bannersInSlot, err := storage.GetBannersInSlot(ctx, "slot3")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, bannersInSlot, 1, "insert should be committed")
+	})
+
+	t.Run("test stats", func(t *testing.T) {
+		stats, err := storage.GetStats(ctx, sqlstorage.StatsFilter{SlotID: "slot2"})
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, []sqlstorage.StatsItem{{BannerID: "banner2", Views: 1, Clicks: 1}}, stats)
+
+		stats, err = storage.GetStats(ctx, sqlstorage.StatsFilter{SlotID: "slot2", GroupBy: sqlstorage.GroupByHour})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, stats, 1, "slice should have 1 item")
+		require.WithinDuration(t, time.Now().UTC().Truncate(time.Hour), stats[0].Period, time.Hour)
+
+		stats, err = storage.GetStats(ctx, sqlstorage.StatsFilter{SlotID: "slot2", From: time.Now().Add(time.Hour)})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, stats, 0, "should be empty array")
+	})
+
+	t.Run("test purge events keeps counters", func(t *testing.T) {
+		err := storage.AddBannerRotation(ctx, "banner4", "slot4")
+		require.NoError(t, err, "should be without errors")
+
+		err = storage.AddViewEvent(ctx, "banner4", "slot4", "social_demo1", time.Now().String())
+		require.NoError(t, err, "should be without errors")
+
+		err = storage.AddClickEvent(ctx, "banner4", "slot4", "social_demo1", time.Now().String())
+		require.NoError(t, err, "should be without errors")
+
+		_, err = storage.PurgeEvents(ctx, time.Now().Add(time.Hour), false)
+		require.NoError(t, err, "should be without errors")
+
+		counters, err := storage.GetEventCountsInSlots(ctx, []string{"slot4"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, counters, 1, "slice should have 1 item")
+		require.Equal(t, 1, counters[0].Views, "purged views should be counted once")
+		require.Equal(t, 1, counters[0].Clicks, "purged clicks should be counted once")
+
+		notViewedBanners, err := storage.GetNotViewedBanners(ctx, "slot4", "social_demo1")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, notViewedBanners, 0, "purged views should still count")
+
+		stats, err := storage.GetStats(ctx, sqlstorage.StatsFilter{SlotID: "slot4", SocialDemoID: "social_demo1"})
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, []sqlstorage.StatsItem{{BannerID: "banner4", Views: 1, Clicks: 1}}, stats, "purged events should be counted")
+	})
+
+	t.Run("test banner read, list and update", func(t *testing.T) {
+		creative := sqlstorage.Creative{
+			ImageURL:   "https://cdn.example.com/banner1.png",
+			LandingURL: "https://example.com",
+			AltText:    "banner",
+			Width:      300,
+			Height:     250,
+			MimeType:   "image/png",
+		}
+
+		fields := []string{"description", "image_url", "landing_url", "alt_text", "width", "height", "mime_type"}
+
+		_, err := storage.UpdateBanner(ctx, "banner1", sqlstorage.BannerItem{Description: "description", Creative: creative}, fields)
+		require.NoError(t, err, "should be without errors")
+
+		updated, err := storage.UpdateBanner(ctx, "banner1", sqlstorage.BannerItem{Description: "new description"},
+			[]string{"description"})
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, "new description", updated.Description, "updated banner should be returned")
+
+		banner, err := storage.GetBanner(ctx, "banner1")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, "new description", banner.Description, "description should be updated")
+		require.Equal(t, creative, banner.Creative, "creative should be kept by description update")
+
+		_, err = storage.UpdateBanner(ctx, "banner1", sqlstorage.BannerItem{}, []string{"id"})
+		require.ErrorIs(t, err, sqlstorage.ErrUnknownField)
+
+		banners, _, err := storage.ListBanners(ctx, sqlstorage.ListFilter{Description: "new"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, banners, 1)
+		require.Equal(t, creative, banners[0].Creative, "creative should be listed")
+
+		_, err = storage.GetBanner(ctx, "unknown")
+		require.ErrorIs(t, err, sqlstorage.ErrNotFound)
+
+		_, err = storage.UpdateBanner(ctx, "unknown", sqlstorage.BannerItem{}, nil)
+		require.ErrorIs(t, err, sqlstorage.ErrNotFound)
+	})
+
+	t.Run("test slot sizes", func(t *testing.T) {
+		sizes := []sqlstorage.Size{{Width: 300, Height: 250}, {Width: 728, Height: 90}}
+
+		_, err := storage.CreateSlot(ctx, "sized", "description", append(sizes, sizes[0]))
+		require.NoError(t, err, "should be without errors")
+
+		slot, err := storage.GetSlot(ctx, "sized")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, sizes, slot.Sizes, "sizes should be stored once")
+
+		slots, _, err := storage.ListSlots(ctx, sqlstorage.ListFilter{Description: "description"})
+		require.NoError(t, err, "should be without errors")
+
+		for _, slot := range slots {
+			if slot.ID == "sized" {
+				require.Equal(t, sizes, slot.Sizes, "sizes should be listed")
+			}
+		}
+
+		slot, err = storage.UpdateSlot(ctx, "sized", sqlstorage.SlotItem{Description: "new description"}, []string{"description"})
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, sizes, slot.Sizes, "sizes should be kept by description update")
+
+		_, err = storage.UpdateSlot(ctx, "sized", sqlstorage.SlotItem{}, []string{"sizes"})
+		require.NoError(t, err, "should be without errors")
+
+		slot, err = storage.GetSlot(ctx, "sized")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, "new description", slot.Description, "description should be updated")
+		require.Empty(t, slot.Sizes, "sizes should be replaced")
+
+		err = storage.DeleteSlot(ctx, "sized", false)
+		require.NoError(t, err, "should be without errors")
+	})
+
+	t.Run("test delete banner in rotation", func(t *testing.T) {
+		_, err := storage.CreateSlot(ctx, "slot5", "description", nil)
+		require.NoError(t, err, "should be without errors")
+
+		err = storage.AddBannerRotation(ctx, "banner1", "slot5")
+		require.NoError(t, err, "should be without errors")
+
+		err = storage.DeleteSlot(ctx, "slot5", false)
+		require.ErrorIs(t, err, sqlstorage.ErrInRotation)
+
+		err = storage.DeleteBanner(ctx, "banner1", false)
+		require.ErrorIs(t, err, sqlstorage.ErrInRotation)
+
+		err = storage.DeleteBanner(ctx, "banner1", true)
+		require.NoError(t, err, "should be without errors")
+
+		bannersInSlot, err := storage.GetBannersInSlot(ctx, "slot5")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, bannersInSlot, 0, "banner should be removed from rotation")
+
+		err = storage.DeleteSlot(ctx, "slot5", false)
+		require.NoError(t, err, "should be without errors")
+
+		err = storage.DeleteBanner(ctx, "banner1", true)
+		require.ErrorIs(t, err, sqlstorage.ErrNotFound)
+	})
+
+	t.Run("test social demo delete", func(t *testing.T) {
+		_, err := storage.CreateSocialDemo(ctx, "social_demo9", "description")
+		require.NoError(t, err, "should be without errors")
+
+		err = storage.DeleteSocialDemo(ctx, "social_demo9", false)
+		require.NoError(t, err, "should be without errors")
+
+		socialDemos, _, err := storage.ListSocialDemos(ctx, sqlstorage.ListFilter{})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, socialDemos, 0)
+	})
+
+	t.Run("test list pagination and filters", func(t *testing.T) {
+		for _, id := range []string{"page1", "page2", "page3", "page4", "page5"} {
+			_, err := storage.CreateSlot(ctx, id, "page "+id, nil)
+			require.NoError(t, err, "should be without errors")
+		}
+
+		err := storage.AddBannerRotation(ctx, "banner2", "page3")
+		require.NoError(t, err, "should be without errors")
+
+		filter := sqlstorage.ListFilter{PageSize: 2, Description: "PAGE", OrderBy: sqlstorage.SortByCreatedAtDesc}
+
+		var ids []string
+
+		for {
+			slots, next, err := storage.ListSlots(ctx, filter)
+			require.NoError(t, err, "should be without errors")
+			require.LessOrEqual(t, len(slots), 2, "page should not exceed page size")
+
+			for _, slot := range slots {
+				ids = append(ids, slot.ID)
+			}
+
+			if next == "" {
+				break
+			}
+
+			filter.PageToken = next
+		}
+
+		require.Equal(t, []string{"page5", "page4", "page3", "page2", "page1"}, ids, "every slot should be listed once")
+
+		slots, _, err := storage.ListSlots(ctx, sqlstorage.ListFilter{Description: "page", Status: sqlstorage.StatusActive})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, slots, 1)
+		require.Equal(t, "page3", slots[0].ID, "only slot in rotation should be active")
+
+		slots, next, err := storage.ListSlots(ctx, sqlstorage.ListFilter{PageSize: 3, Description: "page", OrderBy: sqlstorage.SortByCreatedAt})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, slots, 3)
+
+		_, _, err = storage.ListSlots(ctx, sqlstorage.ListFilter{PageToken: next})
+		require.ErrorIs(t, err, sqlstorage.ErrInvalidPageToken, "token of other sort order should be rejected")
+
+		_, _, err = storage.ListSlots(ctx, sqlstorage.ListFilter{PageToken: next, OrderBy: sqlstorage.SortByCreatedAt})
+		require.ErrorIs(t, err, sqlstorage.ErrInvalidPageToken, "token of other filter should be rejected")
+
+		slots, _, err = storage.ListSlots(ctx, sqlstorage.ListFilter{PageSize: 3, PageToken: next, Description: "page", OrderBy: sqlstorage.SortByCreatedAt})
+		require.NoError(t, err, "token should be accepted with other page size")
+		require.Equal(t, "page4", slots[0].ID, "next page should start after the token")
+
+		rotations, _, err := storage.ListRotations(ctx, sqlstorage.ListFilter{SlotID: "page3"})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, rotations, 1)
+		require.Equal(t, "banner2", rotations[0].BannerID, "bannerID should be same")
+
+		_, _, err = storage.ListRotations(ctx, sqlstorage.ListFilter{Description: "page"})
+		require.ErrorIs(t, err, sqlstorage.ErrUnsupportedFilter)
+	})
+
+	t.Run("test failed replica falls back to primary", func(t *testing.T) {
+		// An empty in-memory database has no tables, so every replica query fails.
+		err := storage.ConnectReplicas(ctx, []string{":memory:"})
+		require.NoError(t, err, "should be without errors")
+
+		bannersInSlot, err := storage.GetBannersInSlot(ctx, "slot4")
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, bannersInSlot, 1, "rows should be read from primary")
+	})
+}
+
+func TestMigrate(t *testing.T) {
+	storage, err := New(context.Background(), ":memory:", time.Second)
+	require.NoError(t, err, "should be without errors")
+
+	defer storage.Close()
+
+	ctx := context.Background()
+
+	t.Run("test applied migrations are skipped", func(t *testing.T) {
+		require.NoError(t, storage.Migrate(ctx, migrations.SQLite), "should be without errors")
+
+		next := fstest.MapFS{
+			"0001_init.sql":  {Data: []byte("CREATE TABLE slots (id TEXT)")},
+			"1000_notes.sql": {Data: []byte("CREATE TABLE notes (id TEXT)")},
+		}
+
+		require.NoError(t, storage.Migrate(ctx, next), "only new migrations should be applied")
+		require.NoError(t, storage.Migrate(ctx, next), "should be without errors")
+
+		_, err := storage.CreateBanner(ctx, "banner1", "description", sqlstorage.Creative{})
+		require.NoError(t, err, "should be without errors")
+	})
+
+	t.Run("test invalid migrations", func(t *testing.T) {
+		err := storage.Migrate(ctx, fstest.MapFS{"notes.sql": {Data: []byte("SELECT 1")}})
+		require.ErrorIs(t, err, sqlstorage.ErrInvalidMigration)
+
+		err = storage.Migrate(ctx, fstest.MapFS{
+			"1001_a.sql": {Data: []byte("SELECT 1")},
+			"1001_b.sql": {Data: []byte("SELECT 1")},
+		})
+		require.ErrorIs(t, err, sqlstorage.ErrInvalidMigration, "versions should be unique")
+	})
+
+	t.Run("test failed migration is rolled back", func(t *testing.T) {
+		err := storage.Migrate(ctx, fstest.MapFS{
+			"1002_tags.sql":   {Data: []byte("CREATE TABLE tags (id TEXT)")},
+			"1003_broken.sql": {Data: []byte("CREATE TABLE")},
+		})
+		require.Error(t, err, "broken migration should fail")
+
+		err = storage.Migrate(ctx, fstest.MapFS{"1002_tags.sql": {Data: []byte("CREATE TABLE tags (id TEXT)")}})
+		require.NoError(t, err, "rolled back migration should be applied again")
+	})
+
+	t.Run("test data of the first release is migrated", func(t *testing.T) {
+		db, err := sqlx.ConnectContext(ctx, driverName, withForeignKeys(":memory:"))
+		require.NoError(t, err, "should be without errors")
+
+		db.SetMaxOpenConns(1)
+
+		legacy := sqlstorage.NewWithDB(db, time.Second)
+		defer legacy.Close()
+
+		first, err := fs.ReadFile(migrations.SQLite, "0001_init.sql")
+		require.NoError(t, err, "should be without errors")
+		require.NoError(t, legacy.Migrate(ctx, fstest.MapFS{"0001_init.sql": {Data: first}}), "should be without errors")
+
+		_, err = db.ExecContext(ctx, `INSERT INTO slots (id, description) VALUES ('slot1', '');
+			INSERT INTO banners (id, description) VALUES ('banner1', '');
+			INSERT INTO banners_rotation (slot_id, banner_id) VALUES ('slot1', 'banner1'), ('slot1', 'banner1'), ('slot1', 'deleted');
+			INSERT INTO views (slot_id, banner_id, social_demo_id, date)
+				VALUES ('slot1', 'banner1', 'social_demo1', '2022-10-19 15:53:00.123 +0300 MSK m=+1.5')`)
+		require.NoError(t, err, "should be without errors")
+
+		require.NoError(t, legacy.Migrate(ctx, migrations.SQLite), "should be without errors")
+
+		var createdAt time.Time
+
+		require.NoError(t, db.GetContext(ctx, &createdAt, "SELECT created_at FROM views"), "should be without errors")
+		require.Equal(t, time.Date(2022, 10, 19, 12, 53, 0, 0, time.UTC), createdAt.UTC(), "creation time should be taken from the date")
+
+		rotations, _, err := legacy.ListRotations(ctx, sqlstorage.ListFilter{})
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, rotations, 1, "duplicate and dangling rotation entries should be dropped")
+	})
+}
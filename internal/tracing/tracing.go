@@ -0,0 +1,69 @@
+// Package tracing sets up OpenTelemetry tracing of the service.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/VladimirButakov/otus-project/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+)
+
+const serviceName = "banners-rotation"
+
+var ErrUnknownExporter = errors.New("unknown exporter")
+
+// New sets the global propagator and the global tracer provider of the configured
+// exporter, and returns a function which flushes the spans and stops the provider.
+// With the none exporter spans are not recorded, but trace context is propagated.
+func New(ctx context.Context, conf config.TracingConf) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+
+	switch conf.Exporter {
+	case "", "none":
+		return func(ctx context.Context) error { return nil }, nil
+	case "stdout":
+		var err error
+
+		exporter, err = stdouttrace.New()
+		if err != nil {
+			return nil, fmt.Errorf("cannot create stdout exporter, %w", err)
+		}
+	case "otlp":
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(conf.Endpoint)}
+		if conf.Insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+
+		var err error
+
+		exporter, err = otlptracegrpc.New(ctx, options...)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create otlp exporter, %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("cannot use exporter %s, %w", conf.Exporter, ErrUnknownExporter)
+	}
+
+	provider := newProvider(exporter, conf.SampleRatio)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func newProvider(exporter sdktrace.SpanExporter, sampleRatio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+}
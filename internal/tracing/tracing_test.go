@@ -0,0 +1,22 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VladimirButakov/otus-project/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("test stdout exporter", func(t *testing.T) {
+		shutdown, err := New(context.Background(), config.TracingConf{Exporter: "stdout", SampleRatio: 1})
+		require.NoError(t, err, "should be without errors")
+		require.NoError(t, shutdown(context.Background()), "should be without errors")
+	})
+
+	t.Run("test unknown exporter", func(t *testing.T) {
+		_, err := New(context.Background(), config.TracingConf{Exporter: "zipkin"})
+		require.ErrorIs(t, err, ErrUnknownExporter, "unknown exporter should fail")
+	})
+}
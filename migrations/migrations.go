@@ -0,0 +1,34 @@
+// Package migrations embeds the numbered schema migrations of the storage
+// backends. Each file is named NNNN_name.sql and is applied once, in the order
+// of its number; applied migrations are never edited, changes go to new files.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+)
+
+var (
+	//go:embed postgres/*.sql
+	postgres embed.FS
+
+	//go:embed sqlite/*.sql
+	sqlite embed.FS
+)
+
+// Postgres are the migrations of the postgres backend, starting from the schema
+// of the first release.
+var Postgres = sub(postgres, "postgres")
+
+// SQLite are the migrations of the embedded SQLite backend. They mirror the
+// postgres schema without the postgres specific event table partitioning.
+var SQLite = sub(sqlite, "sqlite")
+
+func sub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+
+	return sub
+}
@@ -0,0 +1,51 @@
+-- Events are partitioned by day of creation, so old days are dropped as a whole.
+-- Events stored before get their creation time from the date column, which holds
+-- time.Time.String() values, such as "2022-10-19 15:53:00.123 +0300 MSK m=+1.5".
+-- Events with a date which does not parse get the time of the migration. All of
+-- them go to the default partitions, which the purge job deletes by created_at.
+ALTER TABLE "clicks" RENAME TO "clicks_unpartitioned";
+
+CREATE TABLE "clicks" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"date" TEXT NOT NULL,
+	"created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+) PARTITION BY RANGE ("created_at");
+
+CREATE TABLE "clicks_default" PARTITION OF "clicks" DEFAULT;
+
+INSERT INTO "clicks" ("slot_id", "banner_id", "social_demo_id", "date", "created_at")
+	SELECT "slot_id", "banner_id", "social_demo_id", "date",
+		COALESCE(substring("date" FROM '^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(?:\.\d+)? [+-]\d{4}')::TIMESTAMPTZ, now())
+	FROM "clicks_unpartitioned";
+
+DROP TABLE "clicks_unpartitioned";
+
+ALTER TABLE "views" RENAME TO "views_unpartitioned";
+
+CREATE TABLE "views" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"date" TEXT NOT NULL,
+	"created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+) PARTITION BY RANGE ("created_at");
+
+CREATE TABLE "views_default" PARTITION OF "views" DEFAULT;
+
+INSERT INTO "views" ("slot_id", "banner_id", "social_demo_id", "date", "created_at")
+	SELECT "slot_id", "banner_id", "social_demo_id", "date",
+		COALESCE(substring("date" FROM '^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(?:\.\d+)? [+-]\d{4}')::TIMESTAMPTZ, now())
+	FROM "views_unpartitioned";
+
+DROP TABLE "views_unpartitioned";
+
+CREATE TABLE "event_counters" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"views" BIGINT NOT NULL DEFAULT 0,
+	"clicks" BIGINT NOT NULL DEFAULT 0,
+	PRIMARY KEY ("slot_id", "banner_id", "social_demo_id")
+);
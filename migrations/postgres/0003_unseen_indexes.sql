@@ -0,0 +1,3 @@
+CREATE INDEX "banners_rotation_slot_idx" ON "banners_rotation" ("slot_id");
+
+CREATE INDEX "views_slot_social_demo_banner_idx" ON "views" ("slot_id", "social_demo_id", "banner_id");
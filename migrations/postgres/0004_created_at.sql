@@ -0,0 +1,21 @@
+-- Lists are ordered by creation time. Entities created before get the time of the migration.
+ALTER TABLE "slots" ADD COLUMN "created_at" TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX "slots_created_at_idx" ON "slots" ("created_at", "id");
+
+ALTER TABLE "banners" ADD COLUMN "created_at" TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX "banners_created_at_idx" ON "banners" ("created_at", "id");
+
+ALTER TABLE "social_demos" ADD COLUMN "created_at" TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX "social_demos_created_at_idx" ON "social_demos" ("created_at", "id");
+
+ALTER TABLE "banners_rotation" ADD COLUMN "created_at" TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- Rotation entries are listed by slot and banner.
+DROP INDEX "banners_rotation_slot_idx";
+
+CREATE INDEX "banners_rotation_slot_idx" ON "banners_rotation" ("slot_id", "banner_id");
+
+CREATE INDEX "banners_rotation_created_at_idx" ON "banners_rotation" ("created_at", "slot_id", "banner_id");
@@ -0,0 +1,18 @@
+-- Duplicate rotation entries and entries of deleted banners or slots are dropped,
+-- as they cannot satisfy the constraints.
+DELETE FROM "banners_rotation" a USING "banners_rotation" b
+	WHERE a.ctid < b.ctid AND a."slot_id" = b."slot_id" AND a."banner_id" = b."banner_id";
+
+DELETE FROM "banners_rotation" r
+	WHERE NOT EXISTS (SELECT 1 FROM "slots" s WHERE s."id" = r."slot_id")
+	OR NOT EXISTS (SELECT 1 FROM "banners" b WHERE b."id" = r."banner_id");
+
+ALTER TABLE "banners_rotation"
+	ADD PRIMARY KEY ("slot_id", "banner_id"),
+	ADD FOREIGN KEY ("slot_id") REFERENCES "slots" ("id"),
+	ADD FOREIGN KEY ("banner_id") REFERENCES "banners" ("id");
+
+-- The primary key replaces the slot index.
+DROP INDEX "banners_rotation_slot_idx";
+
+CREATE INDEX "banners_rotation_banner_idx" ON "banners_rotation" ("banner_id");
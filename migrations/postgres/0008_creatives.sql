@@ -0,0 +1,14 @@
+ALTER TABLE "banners"
+	ADD COLUMN "image_url" TEXT NOT NULL DEFAULT '',
+	ADD COLUMN "landing_url" TEXT NOT NULL DEFAULT '',
+	ADD COLUMN "alt_text" TEXT NOT NULL DEFAULT '',
+	ADD COLUMN "width" INTEGER NOT NULL DEFAULT 0,
+	ADD COLUMN "height" INTEGER NOT NULL DEFAULT 0,
+	ADD COLUMN "mime_type" TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE "slot_sizes" (
+	"slot_id" TEXT NOT NULL REFERENCES "slots" ("id") ON DELETE CASCADE,
+	"width" INTEGER NOT NULL,
+	"height" INTEGER NOT NULL,
+	PRIMARY KEY ("slot_id", "width", "height")
+);
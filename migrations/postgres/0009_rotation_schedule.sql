@@ -0,0 +1,4 @@
+ALTER TABLE "banners_rotation"
+	ADD COLUMN "starts_at" TIMESTAMPTZ,
+	ADD COLUMN "ends_at" TIMESTAMPTZ,
+	ADD COLUMN "time_zone" TEXT NOT NULL DEFAULT 'UTC';
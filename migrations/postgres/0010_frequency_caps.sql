@@ -0,0 +1,7 @@
+ALTER TABLE "banners_rotation"
+	ADD COLUMN "cap_per_hour" INTEGER NOT NULL DEFAULT 0,
+	ADD COLUMN "cap_per_day" INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE "impressions" ADD COLUMN "user_id" TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX "impressions_user_idx" ON "impressions" ("user_id", "created_at") WHERE "user_id" <> '';
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS "slots" (
+	"id" TEXT NOT NULL,
+	"description" TEXT DEFAULT '',
+	PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "banners" (
+	"id" TEXT NOT NULL,
+	"description" TEXT DEFAULT '',
+	PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "social_demos" (
+	"id" TEXT NOT NULL,
+	"description" TEXT DEFAULT '',
+	PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "banners_rotation" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "clicks" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"date" TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "views" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"date" TEXT NOT NULL
+);
@@ -0,0 +1,52 @@
+-- SQLite does not partition tables, so the purge job deletes events by created_at.
+-- Events stored before get their creation time from the date column, which holds
+-- time.Time.String() values, such as "2022-10-19 15:53:00.123 +0300 MSK m=+1.5".
+-- Events with a date which does not parse get the time of the migration. SQLite
+-- cannot add a column with a CURRENT_TIMESTAMP default, so the tables are rebuilt.
+ALTER TABLE "clicks" RENAME TO "clicks_old";
+
+CREATE TABLE "clicks" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"date" TEXT NOT NULL,
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- p is the position of the space before the UTC offset of the date.
+INSERT INTO "clicks" ("slot_id", "banner_id", "social_demo_id", "date", "created_at")
+	SELECT "slot_id", "banner_id", "social_demo_id", "date",
+		COALESCE(datetime(substr("date", 1, 19) || substr("date", p + 1, 3) || ':' || substr("date", p + 4, 2)), CURRENT_TIMESTAMP)
+	FROM (SELECT *, 11 + instr(substr("date", 12), ' ') AS p FROM "clicks_old");
+
+DROP TABLE "clicks_old";
+
+CREATE INDEX "clicks_created_at_idx" ON "clicks" ("created_at");
+
+ALTER TABLE "views" RENAME TO "views_old";
+
+CREATE TABLE "views" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"date" TEXT NOT NULL,
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO "views" ("slot_id", "banner_id", "social_demo_id", "date", "created_at")
+	SELECT "slot_id", "banner_id", "social_demo_id", "date",
+		COALESCE(datetime(substr("date", 1, 19) || substr("date", p + 1, 3) || ':' || substr("date", p + 4, 2)), CURRENT_TIMESTAMP)
+	FROM (SELECT *, 11 + instr(substr("date", 12), ' ') AS p FROM "views_old");
+
+DROP TABLE "views_old";
+
+CREATE INDEX "views_created_at_idx" ON "views" ("created_at");
+
+CREATE TABLE "event_counters" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"views" BIGINT NOT NULL DEFAULT 0,
+	"clicks" BIGINT NOT NULL DEFAULT 0,
+	PRIMARY KEY ("slot_id", "banner_id", "social_demo_id")
+);
@@ -0,0 +1,64 @@
+-- Lists are ordered by creation time. Entities created before get the time of the
+-- migration. SQLite cannot add a column with a CURRENT_TIMESTAMP default, so the
+-- tables are rebuilt.
+ALTER TABLE "slots" RENAME TO "slots_old";
+
+CREATE TABLE "slots" (
+	"id" TEXT NOT NULL,
+	"description" TEXT DEFAULT '',
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY ("id")
+);
+
+INSERT INTO "slots" ("id", "description") SELECT "id", "description" FROM "slots_old";
+
+DROP TABLE "slots_old";
+
+CREATE INDEX "slots_created_at_idx" ON "slots" ("created_at", "id");
+
+ALTER TABLE "banners" RENAME TO "banners_old";
+
+CREATE TABLE "banners" (
+	"id" TEXT NOT NULL,
+	"description" TEXT DEFAULT '',
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY ("id")
+);
+
+INSERT INTO "banners" ("id", "description") SELECT "id", "description" FROM "banners_old";
+
+DROP TABLE "banners_old";
+
+CREATE INDEX "banners_created_at_idx" ON "banners" ("created_at", "id");
+
+ALTER TABLE "social_demos" RENAME TO "social_demos_old";
+
+CREATE TABLE "social_demos" (
+	"id" TEXT NOT NULL,
+	"description" TEXT DEFAULT '',
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY ("id")
+);
+
+INSERT INTO "social_demos" ("id", "description") SELECT "id", "description" FROM "social_demos_old";
+
+DROP TABLE "social_demos_old";
+
+CREATE INDEX "social_demos_created_at_idx" ON "social_demos" ("created_at", "id");
+
+ALTER TABLE "banners_rotation" RENAME TO "banners_rotation_old";
+
+CREATE TABLE "banners_rotation" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO "banners_rotation" ("slot_id", "banner_id") SELECT "slot_id", "banner_id" FROM "banners_rotation_old";
+
+DROP TABLE "banners_rotation_old";
+
+-- Rotation entries are listed by slot and banner.
+CREATE INDEX "banners_rotation_slot_idx" ON "banners_rotation" ("slot_id", "banner_id");
+
+CREATE INDEX "banners_rotation_created_at_idx" ON "banners_rotation" ("created_at", "slot_id", "banner_id");
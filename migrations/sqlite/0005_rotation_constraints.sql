@@ -0,0 +1,23 @@
+-- Duplicate rotation entries and entries of deleted banners or slots are dropped,
+-- as they cannot satisfy the constraints. SQLite cannot add constraints to a table,
+-- so it is rebuilt, and the primary key replaces the slot index.
+ALTER TABLE "banners_rotation" RENAME TO "banners_rotation_old";
+
+CREATE TABLE "banners_rotation" (
+	"slot_id" TEXT NOT NULL REFERENCES "slots" ("id"),
+	"banner_id" TEXT NOT NULL REFERENCES "banners" ("id"),
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY ("slot_id", "banner_id")
+);
+
+INSERT OR IGNORE INTO "banners_rotation" ("slot_id", "banner_id", "created_at")
+	SELECT r."slot_id", r."banner_id", r."created_at" FROM "banners_rotation_old" r
+	WHERE EXISTS (SELECT 1 FROM "slots" s WHERE s."id" = r."slot_id")
+	AND EXISTS (SELECT 1 FROM "banners" b WHERE b."id" = r."banner_id")
+	ORDER BY r."rowid";
+
+DROP TABLE "banners_rotation_old";
+
+CREATE INDEX "banners_rotation_banner_idx" ON "banners_rotation" ("banner_id");
+
+CREATE INDEX "banners_rotation_created_at_idx" ON "banners_rotation" ("created_at", "slot_id", "banner_id");
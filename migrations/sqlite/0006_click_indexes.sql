@@ -0,0 +1 @@
+CREATE INDEX "clicks_slot_social_demo_banner_idx" ON "clicks" ("slot_id", "social_demo_id", "banner_id");
@@ -0,0 +1,12 @@
+CREATE TABLE "impressions" (
+	"id" TEXT NOT NULL,
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	"clicked_at" TIMESTAMP,
+	"click_latency_ms" BIGINT,
+	PRIMARY KEY ("id")
+);
+
+CREATE INDEX "impressions_created_at_idx" ON "impressions" ("created_at");
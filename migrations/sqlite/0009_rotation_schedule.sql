@@ -0,0 +1,5 @@
+ALTER TABLE "banners_rotation" ADD COLUMN "starts_at" TIMESTAMP;
+
+ALTER TABLE "banners_rotation" ADD COLUMN "ends_at" TIMESTAMP;
+
+ALTER TABLE "banners_rotation" ADD COLUMN "time_zone" TEXT NOT NULL DEFAULT 'UTC';
@@ -0,0 +1,10 @@
+CREATE TABLE "rotation_targets" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL REFERENCES "social_demos" ("id") ON DELETE RESTRICT,
+	"excluded" BOOLEAN NOT NULL,
+	PRIMARY KEY ("slot_id", "banner_id", "social_demo_id"),
+	FOREIGN KEY ("slot_id", "banner_id") REFERENCES "banners_rotation" ("slot_id", "banner_id") ON DELETE CASCADE
+);
+
+CREATE INDEX "rotation_targets_social_demo_idx" ON "rotation_targets" ("social_demo_id");
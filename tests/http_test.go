@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -23,6 +24,35 @@ type CreateBody struct {
 	Description string `json:"description"`
 }
 
+type StatsResponse struct {
+	Stats []struct {
+		BannerID string  `json:"bannerId"`
+		Views    string  `json:"views"`
+		Clicks   string  `json:"clicks"`
+		CTR      float64 `json:"ctr"`
+		CTRLower float64 `json:"ctrLower"`
+		CTRUpper float64 `json:"ctrUpper"`
+	} `json:"stats"`
+}
+
+type PageBody struct {
+	SlotIDs      []string `json:"slot_ids"`
+	SocialDemoID string   `json:"social_demo_id"`
+	Unique       bool     `json:"unique"`
+}
+
+type PageResponse struct {
+	Banners []struct {
+		SlotID   string `json:"slotId"`
+		BannerID string `json:"bannerId"`
+	} `json:"banners"`
+}
+
+type IdempotentCreateBody struct {
+	CreateBody
+	IfNotExists bool `json:"if_not_exists"`
+}
+
 type AddBannerBody struct {
 	BannerID string `json:"banner_id"`
 	SlotID   string `json:"slot_id"`
@@ -37,21 +67,93 @@ type AddBannerClickBody struct {
 	BannerID     string `json:"banner_id"`
 	SlotID       string `json:"slot_id"`
 	SocialDemoID string `json:"social_demo_id"`
+	ImpressionID string `json:"impression_id,omitempty"`
 }
 
 type GetBannerBody struct {
 	SlotID       string `json:"slot_id"`
 	SocialDemoID string `json:"social_demo_id"`
+	UserID       string `json:"user_id,omitempty"`
+}
+
+type FrequencyCapBody struct {
+	BannerID string `json:"banner_id"`
+	SlotID   string `json:"slot_id"`
+	PerHour  int    `json:"per_hour"`
+	PerDay   int    `json:"per_day"`
+}
+
+type TargetingBody struct {
+	BannerID             string   `json:"banner_id"`
+	SlotID               string   `json:"slot_id"`
+	IncludeSocialDemoIDs []string `json:"include_social_demo_ids,omitempty"`
+	ExcludeSocialDemoIDs []string `json:"exclude_social_demo_ids,omitempty"`
+}
+
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details []struct {
+		Type            string `json:"@type"`
+		Reason          string `json:"reason"`
+		FieldViolations []struct {
+			Field string `json:"field"`
+		} `json:"fieldViolations"`
+	} `json:"details"`
 }
 
 type IDResponse struct {
 	ID string `json:"id"`
 }
 
+type Creative struct {
+	ImageURL string `json:"image_url,omitempty"`
+	Width    int    `json:"width,omitempty"`
+	Height   int    `json:"height,omitempty"`
+}
+
+type Size struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+type CreativeBannerBody struct {
+	CreateBody
+	Creative Creative `json:"creative"`
+}
+
+type SizedSlotBody struct {
+	CreateBody
+	Sizes []Size `json:"sizes"`
+}
+
+type ScheduleBody struct {
+	BannerID string `json:"banner_id"`
+	SlotID   string `json:"slot_id"`
+	StartsAt string `json:"starts_at,omitempty"`
+	EndsAt   string `json:"ends_at,omitempty"`
+	TimeZone string `json:"time_zone,omitempty"`
+}
+
+type GetBannerResponse struct {
+	ID           string `json:"id"`
+	ImpressionID string `json:"impressionId"`
+	Creative     struct {
+		ImageURL string `json:"imageUrl"`
+		Width    int    `json:"width"`
+		Height   int    `json:"height"`
+	} `json:"creative"`
+}
+
 type MessageResponse struct {
 	Message string `json:"message"`
 }
 
+type BannersResponse struct {
+	Banners       []CreateBody `json:"banners"`
+	NextPageToken string       `json:"nextPageToken"`
+}
+
 type ItemDB struct {
 	ID          string `db:"id"`
 	Description string `db:"description"`
@@ -80,8 +182,21 @@ var (
 	HTTPHost    = os.Getenv("TESTS_HTTP_HOST")
 	PostgresDSN = os.Getenv("TESTS_POSTGRES_DSN")
 	AmpqDSN     = os.Getenv("TESTS_AMQP_DSN")
+	AdminAPIKey = os.Getenv("TESTS_ADMIN_API_KEY")
 )
 
+// apiKeyTransport authenticates requests of the default client with the admin API key.
+type apiKeyTransport struct {
+	http.RoundTripper
+}
+
+func (t apiKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("X-Api-Key", AdminAPIKey)
+
+	return t.RoundTripper.RoundTrip(req)
+}
+
 func init() {
 	if HTTPHost == "" {
 		HTTPHost = "http://0.0.0.0:5555"
@@ -94,6 +209,12 @@ func init() {
 	if AmpqDSN == "" {
 		PostgresDSN = "host=0.0.0.0 port=5432 user=postgres password=example dbname=banners-rotation_test sslmode=disable"
 	}
+
+	if AdminAPIKey == "" {
+		AdminAPIKey = "test-admin-key"
+	}
+
+	http.DefaultClient.Transport = apiKeyTransport{http.DefaultTransport}
 }
 
 func TestStorage(t *testing.T) {
@@ -107,18 +228,20 @@ func TestStorage(t *testing.T) {
 	err = producer.Connect()
 	require.NoError(t, err, "should be without errors")
 
-	storage, err := sqlstorage.New(context.Background(), PostgresDSN)
+	storage, err := sqlstorage.New(context.Background(), PostgresDSN, 5*time.Second)
 	require.NoError(t, err, "should be without errors")
 
+	ctx := context.Background()
+
 	t.Run("test banner create", func(t *testing.T) {
 		id := uuid.NewString()
 
-		_, err := storage.CreateBanner(id, "")
+		_, err := storage.CreateBanner(ctx, id, "", sqlstorage.Creative{})
 		require.NoError(t, err, "should be without errors")
 
 		var banner ItemDB
 
-		err = db.Get(&banner, "SELECT * FROM banners WHERE id=$1", id)
+		err = db.Get(&banner, "SELECT id,description FROM banners WHERE id=$1", id)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, id, banner.ID, "item should be created in db")
 	})
@@ -126,12 +249,12 @@ func TestStorage(t *testing.T) {
 	t.Run("test slot create", func(t *testing.T) {
 		id := uuid.NewString()
 
-		_, err := storage.CreateSlot(id, "")
+		_, err := storage.CreateSlot(ctx, id, "", nil)
 		require.NoError(t, err, "should be without errors")
 
 		var slot ItemDB
 
-		err = db.Get(&slot, "SELECT * FROM slots WHERE id=$1", id)
+		err = db.Get(&slot, "SELECT id,description FROM slots WHERE id=$1", id)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, id, slot.ID, "item should be created in db")
 	})
@@ -139,12 +262,12 @@ func TestStorage(t *testing.T) {
 	t.Run("test social demo create", func(t *testing.T) {
 		id := uuid.NewString()
 
-		_, err := storage.CreateSocialDemo(id, "")
+		_, err := storage.CreateSocialDemo(ctx, id, "")
 		require.NoError(t, err, "should be without errors")
 
 		var socialDemo ItemDB
 
-		err = db.Get(&socialDemo, "SELECT * FROM social_demos WHERE id=$1", id)
+		err = db.Get(&socialDemo, "SELECT id,description FROM social_demos WHERE id=$1", id)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, id, socialDemo.ID, "item should be created in db")
 	})
@@ -153,12 +276,17 @@ func TestStorage(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
 
-		err := storage.AddBannerRotation(bannerID, slotID)
+		insertEntities(t, db, slotID, bannerID)
+
+		err := storage.AddBannerRotation(ctx, bannerID, slotID)
 		require.NoError(t, err, "should be without errors")
 
+		err = storage.AddBannerRotation(ctx, bannerID, slotID)
+		require.ErrorIs(t, err, sqlstorage.ErrAlreadyExists, "rotation should not be duplicated")
+
 		var rotation RotationDB
 
-		err = db.Get(&rotation, "SELECT * FROM banners_rotation WHERE banner_id=$1 AND slot_id=$2", bannerID, slotID)
+		err = db.Get(&rotation, "SELECT slot_id,banner_id FROM banners_rotation WHERE banner_id=$1 AND slot_id=$2", bannerID, slotID)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, bannerID, rotation.BannerID, "item should be created in db")
 		require.Equal(t, slotID, rotation.SlotID, "item should be created in db")
@@ -168,15 +296,14 @@ func TestStorage(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
 
-		_, err := db.Query("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
-		require.NoError(t, err, "should be without errors")
+		insertRotation(t, db, slotID, bannerID)
 
-		err = storage.RemoveBannerRotation(bannerID, slotID)
+		err := storage.RemoveBannerRotation(ctx, bannerID, slotID)
 		require.NoError(t, err, "should be without errors")
 
 		var rotation []RotationDB
 
-		err = db.Select(&rotation, "SELECT * FROM banners_rotation WHERE banner_id=$1 AND slot_id=$2", bannerID, slotID)
+		err = db.Select(&rotation, "SELECT slot_id,banner_id FROM banners_rotation WHERE banner_id=$1 AND slot_id=$2", bannerID, slotID)
 		require.NoError(t, err, "should be without errors")
 		require.Len(t, rotation, 0, "selected rotation should be empty")
 	})
@@ -186,12 +313,12 @@ func TestStorage(t *testing.T) {
 		slotID := uuid.NewString()
 		socialDemoID := uuid.NewString()
 
-		err := storage.AddClickEvent(bannerID, slotID, socialDemoID, time.Now().String())
+		err := storage.AddClickEvent(ctx, bannerID, slotID, socialDemoID, time.Now().String())
 		require.NoError(t, err, "should be without errors")
 
 		var click ClickDB
 
-		err = db.Get(&click, "SELECT * FROM clicks WHERE slot_id=$1 AND banner_id=$2 AND social_demo_id=$3", slotID, bannerID, socialDemoID)
+		err = db.Get(&click, "SELECT slot_id,banner_id,social_demo_id,date FROM clicks WHERE slot_id=$1 AND banner_id=$2 AND social_demo_id=$3", slotID, bannerID, socialDemoID)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, bannerID, click.BannerID, "item should be created in db")
 		require.Equal(t, slotID, click.SlotID, "item should be created in db")
@@ -204,12 +331,12 @@ func TestStorage(t *testing.T) {
 		slotID := uuid.NewString()
 		socialDemoID := uuid.NewString()
 
-		err := storage.AddViewEvent(bannerID, slotID, socialDemoID, time.Now().String())
+		err := storage.AddViewEvent(ctx, bannerID, slotID, socialDemoID, time.Now().String())
 		require.NoError(t, err, "should be without errors")
 
 		var view ViewDB
 
-		err = db.Get(&view, "SELECT * FROM views WHERE slot_id=$1 AND banner_id=$2 AND social_demo_id=$3", slotID, bannerID, socialDemoID)
+		err = db.Get(&view, "SELECT slot_id,banner_id,social_demo_id,date FROM views WHERE slot_id=$1 AND banner_id=$2 AND social_demo_id=$3", slotID, bannerID, socialDemoID)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, bannerID, view.BannerID, "item should be created in db")
 		require.Equal(t, slotID, view.SlotID, "item should be created in db")
@@ -217,7 +344,7 @@ func TestStorage(t *testing.T) {
 		require.NotEmpty(t, view.Date, "date should exist")
 	})
 
-	t.Run("test get banners clicks", func(t *testing.T) {
+	t.Run("test count banners clicks", func(t *testing.T) {
 		slotID := uuid.NewString()
 		bannerID := uuid.NewString()
 		socialDemoID := uuid.NewString()
@@ -226,18 +353,17 @@ func TestStorage(t *testing.T) {
 		_, err := db.Exec("INSERT INTO clicks (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
 		require.NoError(t, err, "should be without errors")
 
-		clicks, err := storage.GetBannersClicks(slotID)
+		counts, err := storage.GetEventCountsInSlots(ctx, []string{slotID})
 		require.NoError(t, err, "should be without errors")
 
-		require.Len(t, clicks, 1, "slice should have 1 item")
-		require.NotEmpty(t, clicks[0], "first item should exist")
-		require.Equal(t, bannerID, clicks[0].BannerID, "bannerID should be same")
-		require.Equal(t, slotID, clicks[0].SlotID, "slotID should be same")
-		require.Equal(t, socialDemoID, clicks[0].SocialDemoID, "socialDemoID should be same")
-		require.Equal(t, date, clicks[0].Date, "date should be same")
+		require.Len(t, counts, 1, "slice should have 1 item")
+		require.Equal(t, bannerID, counts[0].BannerID, "bannerID should be same")
+		require.Equal(t, slotID, counts[0].SlotID, "slotID should be same")
+		require.Equal(t, 1, counts[0].Clicks, "click should be counted")
+		require.Equal(t, 0, counts[0].Views, "views should not be counted")
 	})
 
-	t.Run("test get banners views", func(t *testing.T) {
+	t.Run("test count banners views", func(t *testing.T) {
 		slotID := uuid.NewString()
 		bannerID := uuid.NewString()
 		socialDemoID := uuid.NewString()
@@ -246,25 +372,23 @@ func TestStorage(t *testing.T) {
 		_, err := db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
 		require.NoError(t, err, "should be without errors")
 
-		views, err := storage.GetBannersViews(slotID)
+		counts, err := storage.GetEventCountsInSlots(ctx, []string{slotID})
 		require.NoError(t, err, "should be without errors")
 
-		require.Len(t, views, 1, "slice should have 1 item")
-		require.NotEmpty(t, views[0], "first item should exist")
-		require.Equal(t, bannerID, views[0].BannerID, "bannerID should be same")
-		require.Equal(t, slotID, views[0].SlotID, "slotID should be same")
-		require.Equal(t, socialDemoID, views[0].SocialDemoID, "socialDemoID should be same")
-		require.Equal(t, date, views[0].Date, "date should be same")
+		require.Len(t, counts, 1, "slice should have 1 item")
+		require.Equal(t, bannerID, counts[0].BannerID, "bannerID should be same")
+		require.Equal(t, slotID, counts[0].SlotID, "slotID should be same")
+		require.Equal(t, 1, counts[0].Views, "view should be counted")
+		require.Equal(t, 0, counts[0].Clicks, "clicks should not be counted")
 	})
 
 	t.Run("test get not viewed banners", func(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
 
-		_, err := db.Query("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
-		require.NoError(t, err, "should be without errors")
+		insertRotation(t, db, slotID, bannerID)
 
-		notViewedBanners, err := storage.GetNotViewedBanners(slotID)
+		notViewedBanners, err := storage.GetNotViewedBanners(ctx, slotID, uuid.NewString())
 		require.NoError(t, err, "should be without errors")
 
 		require.Len(t, notViewedBanners, 1)
@@ -277,26 +401,47 @@ func TestStorage(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
 
-		_, err := db.Query("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
-		require.NoError(t, err, "should be without errors")
+		insertRotation(t, db, slotID, bannerID)
 
-		_, err = db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, "", "")
+		_, err := db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, "", "")
 		require.NoError(t, err, "should be without errors")
 
-		notViewedBanners, err := storage.GetNotViewedBanners(slotID)
+		notViewedBanners, err := storage.GetNotViewedBanners(ctx, slotID, "")
 		require.NoError(t, err, "should be without errors")
 
 		require.Len(t, notViewedBanners, 0, "should be empty array")
 	})
 
-	t.Run("test get banners in slot", func(t *testing.T) {
+	t.Run("test get not viewed banners in other social demo", func(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
+		socialDemoID := uuid.NewString()
+
+		insertRotation(t, db, slotID, bannerID)
+
+		_, err := db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, "")
+		require.NoError(t, err, "should be without errors")
+
+		_, err = db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", uuid.NewString(), bannerID, "", "")
+		require.NoError(t, err, "should be without errors")
 
-		_, err := db.Query("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
+		notViewedBanners, err := storage.GetNotViewedBanners(ctx, slotID, socialDemoID)
 		require.NoError(t, err, "should be without errors")
+		require.Len(t, notViewedBanners, 0, "banner was viewed by this social demo")
 
-		bannersInSlot, err := storage.GetBannersInSlot(slotID)
+		notViewedBanners, err = storage.GetNotViewedBanners(ctx, slotID, uuid.NewString())
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, notViewedBanners, 1, "banner was not viewed by other social demo")
+		require.Equal(t, bannerID, notViewedBanners[0].BannerID, "bannerID should be same")
+	})
+
+	t.Run("test get banners in slot", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+
+		insertRotation(t, db, slotID, bannerID)
+
+		bannersInSlot, err := storage.GetBannersInSlot(ctx, slotID)
 		require.NoError(t, err, "should be without errors")
 
 		require.Len(t, bannersInSlot, 1)
@@ -312,7 +457,13 @@ func TestHTTP(t *testing.T) {
 	httpAddBanner := HTTPHost + "/api/v1/banners/add"
 	httpRemoveBanner := HTTPHost + "/api/v1/banners/remove"
 	httpAddBannerClick := HTTPHost + "/api/v1/banners/click"
+	httpScheduleRotation := HTTPHost + "/api/v1/admin/rotations/schedule"
+	httpFrequencyCap := HTTPHost + "/api/v1/admin/rotations/frequency-cap"
+	httpTargeting := HTTPHost + "/api/v1/admin/rotations/targeting"
 	httpGetBanner := HTTPHost + "/api/v1/banners/get"
+	httpBanners := HTTPHost + "/api/v1/admin/banners"
+	httpStats := HTTPHost + "/api/v1/admin/stats"
+	httpGetPage := HTTPHost + "/api/v1/banners/page"
 
 	t.Run("test banner create", func(t *testing.T) {
 		id := uuid.NewString()
@@ -375,6 +526,9 @@ func TestHTTP(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
 
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+
 		jsonData, err := json.Marshal(AddBannerBody{BannerID: bannerID, SlotID: slotID})
 		require.NoError(t, err, "should be without errors")
 
@@ -413,20 +567,21 @@ func TestHTTP(t *testing.T) {
 		slotID := uuid.NewString()
 		socialDemoID := uuid.NewString()
 
-		jsonData, err := json.Marshal(AddBannerClickBody{BannerID: bannerID, SlotID: slotID, SocialDemoID: socialDemoID})
-		require.NoError(t, err, "should be without errors")
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
 
-		resp, err := http.Post(httpAddBannerClick, "application/json",
-			bytes.NewBuffer(jsonData))
-		require.NoError(t, err, "should be without errors")
+		var banner GetBannerResponse
 
-		var response MessageResponse
+		postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: socialDemoID}, http.StatusOK, &banner)
 
-		err = json.NewDecoder(resp.Body).Decode(&response)
+		var response MessageResponse
 
-		require.NoError(t, err, "should be without errors")
-		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+		postJSON(t, httpAddBannerClick, AddBannerClickBody{ImpressionID: banner.ImpressionID}, http.StatusOK, &response)
 		require.NotEmpty(t, response.Message, "response should exist")
+
+		postJSON(t, httpAddBannerClick, AddBannerClickBody{BannerID: bannerID, SlotID: slotID, SocialDemoID: socialDemoID},
+			http.StatusBadRequest)
 	})
 
 	t.Run("test get banner", func(t *testing.T) {
@@ -507,4 +662,436 @@ func TestHTTP(t *testing.T) {
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, http.StatusBadRequest, resp.StatusCode, "response statuscode should be bad request")
 	})
+
+	t.Run("test new banner is shown to every social demo", func(t *testing.T) {
+		slotID := uuid.NewString()
+		socialDemoIDs := []string{uuid.NewString(), uuid.NewString()}
+		bannerIDs := []string{uuid.NewString(), uuid.NewString()}
+
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+
+		for _, bannerID := range bannerIDs {
+			postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+			postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+		}
+
+		for _, socialDemoID := range socialDemoIDs {
+			shown := map[string]bool{}
+
+			for range bannerIDs {
+				var response IDResponse
+
+				postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: socialDemoID}, http.StatusOK, &response)
+				shown[response.ID] = true
+			}
+
+			require.Len(t, shown, len(bannerIDs), "every banner should get first impression in social demo")
+		}
+
+		newBannerID := uuid.NewString()
+		postJSON(t, httpCreateBanner, CreateBody{ID: newBannerID}, http.StatusOK)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: newBannerID, SlotID: slotID}, http.StatusOK)
+
+		for _, socialDemoID := range socialDemoIDs {
+			var response IDResponse
+
+			postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: socialDemoID}, http.StatusOK, &response)
+			require.Equal(t, newBannerID, response.ID, "new banner should be shown first in every social demo")
+		}
+	})
+
+	t.Run("test banner read, update and delete", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+		httpBanner := httpBanners + "/" + bannerID
+
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID, Description: "old"}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+		doJSON(t, http.MethodPut, httpBanner, CreateBody{Description: "new " + bannerID}, http.StatusOK)
+
+		var banner CreateBody
+
+		doJSON(t, http.MethodGet, httpBanner, nil, http.StatusOK, &banner)
+		require.Equal(t, "new "+bannerID, banner.Description, "description should be updated")
+
+		var banners BannersResponse
+
+		doJSON(t, http.MethodGet, httpBanners+"?description="+bannerID, nil, http.StatusOK, &banners)
+		require.Equal(t, []CreateBody{banner}, banners.Banners, "banner should be listed")
+
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+		doJSON(t, http.MethodDelete, httpBanner, nil, http.StatusBadRequest)
+		doJSON(t, http.MethodDelete, httpBanner+"?force=true", nil, http.StatusOK)
+		doJSON(t, http.MethodGet, httpBanner, nil, http.StatusNotFound)
+		postJSON(t, httpRemoveBanner, RemoveBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusNotFound)
+	})
+
+	t.Run("test list banners pages", func(t *testing.T) {
+		description := uuid.NewString()
+
+		for i := 0; i < 5; i++ {
+			postJSON(t, httpCreateBanner, CreateBody{ID: uuid.NewString(), Description: description}, http.StatusOK)
+		}
+
+		seen := map[string]bool{}
+		url := httpBanners + "?page_size=2&order_by=SORT_ORDER_CREATED_AT_DESC&description=" + description
+
+		for {
+			var banners BannersResponse
+
+			doJSON(t, http.MethodGet, url, nil, http.StatusOK, &banners)
+			require.LessOrEqual(t, len(banners.Banners), 2, "page should not exceed page size")
+
+			for _, banner := range banners.Banners {
+				require.False(t, seen[banner.ID], "banner should be listed once")
+				seen[banner.ID] = true
+			}
+
+			if banners.NextPageToken == "" {
+				break
+			}
+
+			url = httpBanners + "?page_size=2&order_by=SORT_ORDER_CREATED_AT_DESC&description=" + description +
+				"&page_token=" + banners.NextPageToken
+		}
+
+		require.Len(t, seen, 5, "every banner should be listed")
+	})
+
+	t.Run("test create existing banner", func(t *testing.T) {
+		body := CreateBody{ID: uuid.NewString(), Description: "description"}
+
+		postJSON(t, httpCreateBanner, body, http.StatusOK)
+		postJSON(t, httpCreateBanner, body, http.StatusConflict)
+		postJSON(t, httpCreateBanner, IdempotentCreateBody{CreateBody: body, IfNotExists: true}, http.StatusOK)
+
+		body.Description = "other"
+		postJSON(t, httpCreateBanner, IdempotentCreateBody{CreateBody: body, IfNotExists: true}, http.StatusConflict)
+	})
+
+	t.Run("test add banner to rotation twice", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusBadRequest)
+
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusConflict)
+		postJSON(t, httpAddBanner, map[string]interface{}{"banner_id": bannerID, "slot_id": slotID, "if_not_exists": true}, http.StatusOK)
+	})
+
+	t.Run("test get unique banners for page", func(t *testing.T) {
+		slotIDs := []string{uuid.NewString(), uuid.NewString()}
+		bannerIDs := []string{uuid.NewString(), uuid.NewString()}
+
+		for _, slotID := range slotIDs {
+			postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+		}
+
+		for _, bannerID := range bannerIDs {
+			postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+
+			for _, slotID := range slotIDs {
+				postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+			}
+		}
+
+		for i := 0; i < 3; i++ {
+			var page PageResponse
+
+			postJSON(t, httpGetPage, PageBody{SlotIDs: slotIDs, SocialDemoID: uuid.NewString(), Unique: true}, http.StatusOK, &page)
+			require.Len(t, page.Banners, 2)
+			require.Equal(t, slotIDs[0], page.Banners[0].SlotID, "slots should keep request order")
+			require.NotEqual(t, page.Banners[0].BannerID, page.Banners[1].BannerID, "banners should be unique on page")
+		}
+
+		postJSON(t, httpGetPage, PageBody{SocialDemoID: uuid.NewString()}, http.StatusBadRequest)
+	})
+
+	t.Run("test banner stats", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+		socialDemoID := uuid.NewString()
+
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+
+		var banner GetBannerResponse
+
+		for i := 0; i < 4; i++ {
+			postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: socialDemoID}, http.StatusOK, &banner)
+		}
+
+		postJSON(t, httpAddBannerClick, AddBannerClickBody{ImpressionID: banner.ImpressionID}, http.StatusOK)
+
+		var stats StatsResponse
+
+		doJSON(t, http.MethodGet, httpStats+"?slot_id="+slotID, nil, http.StatusOK, &stats)
+		require.Len(t, stats.Stats, 1, "slice should have 1 item")
+		require.Equal(t, bannerID, stats.Stats[0].BannerID, "bannerID should be same")
+		require.Equal(t, "4", stats.Stats[0].Views, "views should be counted")
+		require.Equal(t, "1", stats.Stats[0].Clicks, "clicks should be counted")
+		require.InDelta(t, 0.25, stats.Stats[0].CTR, 1e-9)
+		require.Less(t, stats.Stats[0].CTRLower, stats.Stats[0].CTR)
+		require.Greater(t, stats.Stats[0].CTRUpper, stats.Stats[0].CTR)
+	})
+
+	t.Run("test click on impression", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+		socialDemoID := uuid.NewString()
+
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+
+		var banner GetBannerResponse
+
+		postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: socialDemoID}, http.StatusOK, &banner)
+		require.Equal(t, bannerID, banner.ID, "bannerID should be same")
+		require.NotEmpty(t, banner.ImpressionID, "impression id should exist")
+
+		postJSON(t, httpAddBannerClick, AddBannerClickBody{BannerID: uuid.NewString(), ImpressionID: banner.ImpressionID}, http.StatusBadRequest)
+		postJSON(t, httpAddBannerClick, AddBannerClickBody{ImpressionID: banner.ImpressionID}, http.StatusOK)
+		postJSON(t, httpAddBannerClick, AddBannerClickBody{ImpressionID: banner.ImpressionID}, http.StatusConflict)
+		postJSON(t, httpAddBannerClick, AddBannerClickBody{ImpressionID: uuid.NewString()}, http.StatusNotFound)
+	})
+
+	t.Run("test scheduled rotation", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		futureBannerID := uuid.NewString()
+		slotID := uuid.NewString()
+
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+		postJSON(t, httpCreateBanner, CreateBody{ID: futureBannerID}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+
+		tomorrow := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+
+		postJSON(t, httpScheduleRotation, ScheduleBody{BannerID: bannerID, SlotID: slotID, EndsAt: tomorrow, TimeZone: "Europe/Moscow"}, http.StatusOK)
+		postJSON(t, httpScheduleRotation, ScheduleBody{BannerID: futureBannerID, SlotID: slotID, StartsAt: tomorrow}, http.StatusOK)
+		postJSON(t, httpScheduleRotation, ScheduleBody{BannerID: bannerID, SlotID: slotID, TimeZone: "Unknown/Zone"}, http.StatusBadRequest)
+
+		for i := 0; i < 3; i++ {
+			var banner GetBannerResponse
+
+			postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: uuid.NewString()}, http.StatusOK, &banner)
+			require.Equal(t, bannerID, banner.ID, "only active banners should be shown")
+		}
+	})
+
+	t.Run("test frequency cap", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+		userID := uuid.NewString()
+
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+		postJSON(t, httpFrequencyCap, FrequencyCapBody{BannerID: bannerID, SlotID: slotID, PerDay: 1}, http.StatusNotFound)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+		postJSON(t, httpFrequencyCap, FrequencyCapBody{BannerID: bannerID, SlotID: slotID, PerDay: 1}, http.StatusOK)
+
+		postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: uuid.NewString(), UserID: userID}, http.StatusOK)
+		postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: uuid.NewString(), UserID: userID}, http.StatusNotFound)
+		postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: uuid.NewString()}, http.StatusOK)
+	})
+
+	t.Run("test targeting", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+		socialDemoID := uuid.NewString()
+
+		postJSON(t, httpCreateBanner, CreateBody{ID: bannerID}, http.StatusOK)
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+		postJSON(t, httpCreateSocialDemo, CreateBody{ID: socialDemoID}, http.StatusOK)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+
+		targeting := TargetingBody{BannerID: bannerID, SlotID: slotID, ExcludeSocialDemoIDs: []string{socialDemoID}}
+
+		doJSON(t, http.MethodPut, httpTargeting, TargetingBody{
+			BannerID: bannerID, SlotID: slotID,
+			IncludeSocialDemoIDs: []string{socialDemoID}, ExcludeSocialDemoIDs: []string{socialDemoID},
+		}, http.StatusBadRequest)
+		doJSON(t, http.MethodPut, httpTargeting, targeting, http.StatusOK)
+
+		postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: socialDemoID}, http.StatusNotFound)
+		postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: uuid.NewString()}, http.StatusOK)
+
+		var response struct {
+			ExcludeSocialDemoIDs []string `json:"excludeSocialDemoIds"`
+		}
+
+		doJSON(t, http.MethodGet, httpTargeting+"?banner_id="+bannerID+"&slot_id="+slotID, nil, http.StatusOK, &response)
+		require.Equal(t, targeting.ExcludeSocialDemoIDs, response.ExcludeSocialDemoIDs, "targeting should be same")
+	})
+
+	t.Run("test error details", func(t *testing.T) {
+		var response ErrorResponse
+
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: uuid.NewString()}, http.StatusBadRequest, &response)
+		require.Len(t, response.Details, 2, "error info and bad request should be returned")
+		require.Equal(t, "INVALID_ARGUMENT", response.Details[0].Reason, "reason should be same")
+		require.Equal(t, "slot_id", response.Details[1].FieldViolations[0].Field, "violated field should be returned")
+
+		response = ErrorResponse{}
+
+		postJSON(t, httpCreateBanner, CreateBody{ID: "banner 1"}, http.StatusBadRequest, &response)
+		require.Equal(t, "id", response.Details[1].FieldViolations[0].Field, "violated field should be returned")
+
+		response = ErrorResponse{}
+
+		doJSON(t, http.MethodGet, httpBanners+"/"+uuid.NewString(), nil, http.StatusNotFound, &response)
+		require.Equal(t, "NOT_FOUND", response.Details[0].Reason, "reason should be same")
+		require.Equal(t, "cannot read banner, not found", response.Message, "message should be same")
+	})
+
+	t.Run("test authorization", func(t *testing.T) {
+		anonymous := &http.Client{}
+
+		request := func(url string, apiKey string, body interface{}) int {
+			jsonData, err := json.Marshal(body)
+			require.NoError(t, err, "should be without errors")
+
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(jsonData))
+			require.NoError(t, err, "should be without errors")
+
+			if apiKey != "" {
+				req.Header.Set("X-Api-Key", apiKey)
+			}
+
+			resp, err := anonymous.Do(req)
+			require.NoError(t, err, "should be without errors")
+
+			defer resp.Body.Close()
+
+			return resp.StatusCode
+		}
+
+		slotID := uuid.NewString()
+		postJSON(t, httpCreateSlot, CreateBody{ID: slotID}, http.StatusOK)
+
+		require.Equal(t, http.StatusForbidden, request(httpCreateBanner, "", CreateBody{}), "admin role should be required")
+		require.Equal(t, http.StatusForbidden, request(httpCreateBanner, "test-public-key", CreateBody{}), "admin role should be required")
+		require.Equal(t, http.StatusUnauthorized, request(httpCreateBanner, "unknown", CreateBody{}), "unknown key should be rejected")
+		require.Equal(t, http.StatusNotFound, request(httpGetBanner, "", GetBannerBody{SlotID: slotID, SocialDemoID: uuid.NewString()}),
+			"anonymous callers should get banners")
+	})
+
+	t.Run("test health and readiness", func(t *testing.T) {
+		for _, url := range []string{HTTPHost + "/healthz", HTTPHost + "/readyz"} {
+			resp, err := (&http.Client{}).Get(url)
+			require.NoError(t, err, "should be without errors")
+			resp.Body.Close()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode, "service should be healthy and ready without credentials")
+		}
+	})
+
+	t.Run("test metrics", func(t *testing.T) {
+		resp, err := (&http.Client{}).Get(HTTPHost + "/metrics")
+		require.NoError(t, err, "should be without errors")
+
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err, "should be without errors")
+
+		require.Equal(t, http.StatusOK, resp.StatusCode, "metrics should be served without credentials")
+		require.Contains(t, string(body), "banners_rotation_grpc_requests_total", "request metrics should be exposed")
+		require.Contains(t, string(body), "banners_rotation_selections_total", "selection metrics should be exposed")
+	})
+
+	t.Run("test openapi", func(t *testing.T) {
+		var spec struct {
+			Swagger string                     `json:"swagger"`
+			Paths   map[string]json.RawMessage `json:"paths"`
+		}
+
+		doJSON(t, http.MethodGet, HTTPHost+"/api/openapi.json", nil, http.StatusOK, &spec)
+		require.Equal(t, "2.0", spec.Swagger, "spec should be openapi v2")
+		require.Contains(t, spec.Paths, "/api/v1/banners/get", "endpoints should be described")
+
+		resp, err := (&http.Client{}).Get(HTTPHost + "/api/docs")
+		require.NoError(t, err, "should be without errors")
+		resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode, "docs should be served without credentials")
+	})
+
+	t.Run("test banner creative and slot sizes", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		wideBannerID := uuid.NewString()
+		slotID := uuid.NewString()
+		creative := Creative{ImageURL: "https://cdn.example.com/" + bannerID + ".png", Width: 300, Height: 250}
+
+		postJSON(t, httpCreateBanner, CreativeBannerBody{CreateBody{ID: bannerID}, creative}, http.StatusOK)
+		postJSON(t, httpCreateBanner, CreativeBannerBody{CreateBody{ID: wideBannerID}, Creative{Width: 728, Height: 90}}, http.StatusOK)
+		postJSON(t, httpCreateSlot, SizedSlotBody{CreateBody{ID: slotID}, []Size{{Width: 300, Height: 250}}}, http.StatusOK)
+
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: wideBannerID, SlotID: slotID}, http.StatusBadRequest)
+		postJSON(t, httpAddBanner, AddBannerBody{BannerID: bannerID, SlotID: slotID}, http.StatusOK)
+
+		var banner GetBannerResponse
+
+		postJSON(t, httpGetBanner, GetBannerBody{SlotID: slotID, SocialDemoID: uuid.NewString()}, http.StatusOK, &banner)
+		require.Equal(t, bannerID, banner.ID, "bannerID should be same")
+		require.Equal(t, creative.ImageURL, banner.Creative.ImageURL, "creative should be returned")
+		require.Equal(t, creative.Width, banner.Creative.Width, "creative should be returned")
+	})
+}
+
+func insertEntities(t *testing.T, db *sqlx.DB, slotID string, bannerID string) {
+	t.Helper()
+
+	_, err := db.Exec("INSERT INTO slots (id) VALUES ($1) ON CONFLICT DO NOTHING", slotID)
+	require.NoError(t, err, "should be without errors")
+
+	_, err = db.Exec("INSERT INTO banners (id) VALUES ($1) ON CONFLICT DO NOTHING", bannerID)
+	require.NoError(t, err, "should be without errors")
+}
+
+func insertRotation(t *testing.T, db *sqlx.DB, slotID string, bannerID string) {
+	t.Helper()
+
+	insertEntities(t, db, slotID, bannerID)
+
+	_, err := db.Exec("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
+	require.NoError(t, err, "should be without errors")
+}
+
+func postJSON(t *testing.T, url string, body interface{}, statusCode int, response ...interface{}) {
+	t.Helper()
+
+	doJSON(t, http.MethodPost, url, body, statusCode, response...)
+}
+
+func doJSON(t *testing.T, method string, url string, body interface{}, statusCode int, response ...interface{}) {
+	t.Helper()
+
+	var reqBody bytes.Buffer
+
+	if body != nil {
+		err := json.NewEncoder(&reqBody).Encode(body)
+		require.NoError(t, err, "should be without errors")
+	}
+
+	req, err := http.NewRequest(method, url, &reqBody)
+	require.NoError(t, err, "should be without errors")
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "should be without errors")
+
+	defer resp.Body.Close()
+
+	require.Equal(t, statusCode, resp.StatusCode, "unexpected response statuscode")
+
+	for _, r := range response {
+		err = json.NewDecoder(resp.Body).Decode(r)
+		require.NoError(t, err, "should be without errors")
+	}
 }